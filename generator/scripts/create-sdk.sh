@@ -105,6 +105,14 @@ for API_FILE in $SUB_API_FILES; do
   else
     echo "  ✗ Failed to generate handler code for $FILENAME"
   fi
+
+  # Generate extensions (workspace client, ...) from the generated client
+  echo "  Generating extension code..."
+  if go run generate-extensions.go "$PACKAGE_NAME" "$CLIENT_FILE" "$API_OUTPUT_DIR"; then
+    echo "  ✓ Generated extension code in $API_OUTPUT_DIR"
+  else
+    echo "  ✗ Failed to generate extension code for $FILENAME"
+  fi
 done
 
 # Generate main SDK wrapper file
@@ -0,0 +1,590 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+)
+
+// Param is a single parameter of a generated client method.
+type Param struct {
+	Name     string // e.g., "workspaceUUID"
+	Type     string // e.g., "string", "...RequestEditorFn"
+	Variadic bool
+}
+
+// Method is a method of the generated ClientWithResponsesInterface.
+type Method struct {
+	Name    string // e.g., "ListGroupsWithResponse"
+	Params  []Param
+	Results string // e.g., "(*ListGroupsResponse, error)"
+}
+
+// WorkspaceMethod is a Method with its workspaceUUID parameter bound.
+type WorkspaceMethod struct {
+	Method
+	Signature string // parameters without workspaceUUID
+	CallArgs  string // arguments forwarded to the client
+}
+
+// Operation is an API operation, read from the New<Name>Request functions of the generated client.
+type Operation struct {
+	Name         string // e.g., "CreateGroup"
+	Method       string // e.g., "POST"
+	PathTemplate string // e.g., "/iam/v1/api/v1/workspace/{workspaceUUID}/group/"
+	Idempotent   bool
+}
+
+// Response is a <Name>Response type of the generated client.
+type Response struct {
+	Name string // e.g., "ListGroupsResponse"
+	// Payload is the field holding the success payload, e.g. "JSON200"; empty when the
+	// operation returns none or several (Ambiguous).
+	Payload     string
+	PayloadType string // e.g., "[]IamGroup" or "*IamGroup"
+	Deref       bool   // slices and maps are returned by value
+	Ambiguous   bool
+}
+
+// ResultMethod is the OK-or-error companion of a WithResponse method, e.g. ListGroups for
+// ListGroupsWithResponse: it returns the success payload or the error of the call.
+type ResultMethod struct {
+	Name      string // e.g., "ListGroups"
+	Method    Method // the WithResponse method it calls
+	Signature string
+	CallArgs  string
+	Response  Response
+}
+
+type ExtensionsData struct {
+	PackageName      string
+	Imports          []string
+	WorkspaceMethods []WorkspaceMethod
+	Operations       []Operation
+	Responses        []Response
+	HandlerResults   []ResultMethod
+	WorkspaceResults []ResultMethod
+	ResultImports    []string
+	SensitiveFields  []string
+}
+
+// extensions lists the templates rendered for every service and the files they produce.
+// Generated files are always overwritten.
+var extensions = []struct {
+	Template string
+	Output   string
+}{
+	{Template: "service.go.tmpl", Output: "service.gen.go"},
+	{Template: "workspace.go.tmpl", Output: "workspace.gen.go"},
+	{Template: "operations.go.tmpl", Output: "operations.gen.go"},
+	{Template: "errors.go.tmpl", Output: "errors.gen.go"},
+	{Template: "results.go.tmpl", Output: "results.gen.go"},
+}
+
+const workspaceParam = "workspaceUUID"
+
+// idempotencyOverridesFile maps service -> operation -> idempotent, for operations whose
+// HTTP method does not tell, e.g. a POST that only reads.
+const idempotencyOverridesFile = "../configs/idempotency.json"
+
+// idempotentMethods are the methods that can be repeated without changing the result (RFC 9110).
+var idempotentMethods = map[string]bool{
+	"GET": true, "HEAD": true, "OPTIONS": true, "TRACE": true, "PUT": true, "DELETE": true,
+}
+
+func main() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: go run generate-extensions.go <package-name> <client-file> <output-directory>")
+		fmt.Println("Example: go run generate-extensions.go iam_v1 ../../sdk/core/iam_v1/client.gen.go ../../sdk/core/iam_v1")
+		os.Exit(1)
+	}
+
+	packageName := os.Args[1]
+	clientFile := os.Args[2]
+	outputDir := os.Args[3]
+
+	methods, err := parseClientMethods(clientFile)
+	if err != nil {
+		fmt.Printf("Error parsing client file: %v\n", err)
+		os.Exit(1)
+	}
+
+	operations, err := parseOperations(clientFile)
+	if err != nil {
+		fmt.Printf("Error parsing operations: %v\n", err)
+		os.Exit(1)
+	}
+	if err := applyIdempotencyOverrides(packageName, operations); err != nil {
+		fmt.Printf("Error reading %s: %v\n", idempotencyOverridesFile, err)
+		os.Exit(1)
+	}
+
+	responses, err := parseResponses(clientFile)
+	if err != nil {
+		fmt.Printf("Error parsing responses: %v\n", err)
+		os.Exit(1)
+	}
+
+	typesFile := filepath.Join(filepath.Dir(clientFile), "types.gen.go")
+	sensitiveFields, err := parseSensitiveFields(typesFile)
+	if err != nil {
+		fmt.Printf("Error parsing types file: %v\n", err)
+		os.Exit(1)
+	}
+
+	data := ExtensionsData{
+		PackageName:      packageName,
+		WorkspaceMethods: workspaceMethods(methods),
+		Operations:       operations,
+		Responses:        responses,
+		SensitiveFields:  sensitiveFields,
+	}
+	data.HandlerResults = resultMethods(methods, responses, false)
+	data.WorkspaceResults = resultMethods(methods, responses, true)
+
+	var bound, results []Method
+	for _, method := range data.WorkspaceMethods {
+		bound = append(bound, method.Method)
+	}
+	for _, method := range data.HandlerResults {
+		results = append(results, method.Method)
+	}
+	data.Imports = collectImports(bound)
+	data.ResultImports = collectImports(results)
+
+	for _, extension := range extensions {
+		outputFile := filepath.Join(outputDir, extension.Output)
+		if err := render(extension.Template, outputFile, data); err != nil {
+			fmt.Printf("Error generating %s: %v\n", outputFile, err)
+			os.Exit(1)
+		}
+		fmt.Printf("✓ Generated extension file: %s\n", outputFile)
+	}
+}
+
+func render(templateName, outputFile string, data ExtensionsData) error {
+	templatePath := filepath.Join("..", "templates", templateName)
+	tmplContent, err := os.ReadFile(templatePath)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New(templateName).Parse(string(tmplContent))
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %w", err)
+	}
+	return os.WriteFile(outputFile, source, 0644)
+}
+
+// parseClientMethods reads the methods of ClientWithResponsesInterface from the generated client.
+func parseClientMethods(clientFile string) ([]Method, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, clientFile, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var iface *ast.InterfaceType
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.TypeSpec)
+		if !ok || spec.Name.Name != "ClientWithResponsesInterface" {
+			return true
+		}
+		iface, _ = spec.Type.(*ast.InterfaceType)
+		return false
+	})
+	if iface == nil {
+		return nil, fmt.Errorf("ClientWithResponsesInterface not found in %s", clientFile)
+	}
+
+	var methods []Method
+	for _, field := range iface.Methods.List {
+		funcType, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			continue
+		}
+		method := Method{
+			Name:    field.Names[0].Name,
+			Results: "(" + fieldListString(fset, funcType.Results) + ")",
+		}
+		for _, param := range funcType.Params.List {
+			typ := nodeString(fset, param.Type)
+			_, variadic := param.Type.(*ast.Ellipsis)
+			for _, name := range param.Names {
+				method.Params = append(method.Params, Param{Name: name.Name, Type: typ, Variadic: variadic})
+			}
+		}
+		methods = append(methods, method)
+	}
+	return methods, nil
+}
+
+// parseOperations reads the method and path template of every New<Name>Request function.
+// The variants with a JSON body only delegate to New<Name>RequestWithBody and are skipped.
+func parseOperations(clientFile string) ([]Operation, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, clientFile, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var operations []Operation
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Body == nil || !strings.HasPrefix(fn.Name.Name, "New") {
+			continue
+		}
+		name := strings.TrimPrefix(fn.Name.Name, "New")
+		name = strings.TrimSuffix(name, "WithBody")
+		if !strings.HasSuffix(name, "Request") {
+			continue
+		}
+		operation := Operation{Name: strings.TrimSuffix(name, "Request")}
+
+		var pathFormat string
+		var pathParams []string
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.AssignStmt:
+				if ident, ok := node.Lhs[0].(*ast.Ident); ok && ident.Name == "operationPath" && node.Tok == token.DEFINE {
+					pathFormat = operationPathFormat(node.Rhs[0])
+				}
+			case *ast.CallExpr:
+				switch callName(node) {
+				case "runtime.StyleParamWithLocation":
+					if len(node.Args) > 3 && callArgString(node.Args[3]) == "runtime.ParamLocationPath" {
+						pathParams = append(pathParams, stringLiteral(node.Args[2]))
+					}
+				case "http.NewRequest", "http.NewRequestWithContext":
+					methodArg := node.Args[0]
+					if callName(node) == "http.NewRequestWithContext" {
+						methodArg = node.Args[1]
+					}
+					operation.Method = stringLiteral(methodArg)
+				}
+			}
+			return true
+		})
+		if pathFormat == "" || operation.Method == "" {
+			continue
+		}
+
+		for _, param := range pathParams {
+			pathFormat = strings.Replace(pathFormat, "%s", "{"+param+"}", 1)
+		}
+		operation.PathTemplate = pathFormat
+		operation.Idempotent = idempotentMethods[operation.Method]
+		operations = append(operations, operation)
+	}
+	sort.Slice(operations, func(i, j int) bool { return operations[i].Name < operations[j].Name })
+	return operations, nil
+}
+
+// parseResponses reads the response types of the generated client, the structs holding an
+// HTTPResponse, with their JSON2xx success payload.
+func parseResponses(clientFile string) ([]Response, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, clientFile, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []Response
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		structType, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		response := Response{Name: spec.Name.Name}
+		isResponse := false
+		for _, field := range structType.Fields.List {
+			if len(field.Names) == 0 {
+				continue
+			}
+			name := field.Names[0].Name
+			switch {
+			case name == "HTTPResponse":
+				isResponse = true
+			case strings.HasPrefix(name, "JSON2"):
+				if response.Payload != "" {
+					response.Ambiguous = true
+				}
+				response.Payload = name
+				response.PayloadType = nodeString(fset, field.Type)
+			}
+		}
+		if !isResponse {
+			return false
+		}
+		if response.Ambiguous {
+			response.Payload, response.PayloadType = "", ""
+		}
+		if elem := strings.TrimPrefix(response.PayloadType, "*"); strings.HasPrefix(elem, "[]") || strings.HasPrefix(elem, "map[") {
+			response.PayloadType, response.Deref = elem, true
+		}
+		responses = append(responses, response)
+		return false
+	})
+	sort.Slice(responses, func(i, j int) bool { return responses[i].Name < responses[j].Name })
+	return responses, nil
+}
+
+// applyIdempotencyOverrides sets the idempotency of the operations listed for the service.
+func applyIdempotencyOverrides(packageName string, operations []Operation) error {
+	content, err := os.ReadFile(idempotencyOverridesFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var overrides map[string]map[string]bool
+	if err := json.Unmarshal(content, &overrides); err != nil {
+		return err
+	}
+
+	known := map[string]*Operation{}
+	for i := range operations {
+		known[operations[i].Name] = &operations[i]
+	}
+	for name, idempotent := range overrides[packageName] {
+		operation, ok := known[name]
+		if !ok {
+			return fmt.Errorf("unknown operation %s.%s", packageName, name)
+		}
+		operation.Idempotent = idempotent
+	}
+	return nil
+}
+
+// parseSensitiveFields returns the JSON names of the string fields of the service's schemas
+// that hold secrets, per constants.SensitiveFields and constants.SensitiveFieldSuffixes.
+func parseSensitiveFields(typesFile string) ([]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, typesFile, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		structType, ok := node.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		for _, field := range structType.Fields.List {
+			if field.Tag == nil {
+				continue
+			}
+			if typ := nodeString(fset, field.Type); typ != "string" && typ != "*string" {
+				continue
+			}
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				continue
+			}
+			name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+			if isSensitiveField(name) {
+				found[name] = true
+			}
+		}
+		return true
+	})
+
+	fields := make([]string, 0, len(found))
+	for name := range found {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func isSensitiveField(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, sensitive := range constants.SensitiveFields {
+		if name == sensitive {
+			return true
+		}
+	}
+	for _, suffix := range constants.SensitiveFieldSuffixes {
+		if strings.HasSuffix(name, "_"+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// operationPathFormat returns the format string of `operationPath := fmt.Sprintf("...", ...)`
+// or the literal of `operationPath := "..."`.
+func operationPathFormat(expr ast.Expr) string {
+	if call, ok := expr.(*ast.CallExpr); ok && callName(call) == "fmt.Sprintf" {
+		return stringLiteral(call.Args[0])
+	}
+	return stringLiteral(expr)
+}
+
+func callName(call *ast.CallExpr) string {
+	return callArgString(call.Fun)
+}
+
+func callArgString(expr ast.Expr) string {
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	pkg, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return pkg.Name + "." + selector.Sel.Name
+}
+
+func stringLiteral(expr ast.Expr) string {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return ""
+	}
+	value, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// workspaceMethods returns the methods taking a workspaceUUID, with that parameter bound.
+func workspaceMethods(methods []Method) []WorkspaceMethod {
+	var result []WorkspaceMethod
+	for _, method := range methods {
+		signature, callArgs, bound := bindParams(method, true)
+		if !bound {
+			continue
+		}
+		result = append(result, WorkspaceMethod{
+			Method:    method,
+			Signature: signature,
+			CallArgs:  callArgs,
+		})
+	}
+	return result
+}
+
+// bindParams returns the parameters and call arguments forwarding method's parameters. With
+// bindWorkspace, a workspaceUUID parameter is replaced by the workspace the client is bound to.
+func bindParams(method Method, bindWorkspace bool) (signature, callArgs string, bound bool) {
+	var params, args []string
+	for _, param := range method.Params {
+		if bindWorkspace && param.Name == workspaceParam && param.Type == "string" {
+			bound = true
+			args = append(args, "w."+workspaceParam)
+			continue
+		}
+		params = append(params, param.Name+" "+param.Type)
+		if param.Variadic {
+			args = append(args, param.Name+"...")
+		} else {
+			args = append(args, param.Name)
+		}
+	}
+	return strings.Join(params, ", "), strings.Join(args, ", "), bound
+}
+
+// resultMethods returns the companions of the WithResponse methods, bound to the workspace
+// with bindWorkspace. Methods taking a raw body, and those whose response has several
+// success payloads, get none.
+func resultMethods(methods []Method, responses []Response, bindWorkspace bool) []ResultMethod {
+	byName := map[string]Response{}
+	for _, response := range responses {
+		byName[response.Name] = response
+	}
+
+	var result []ResultMethod
+	for _, method := range methods {
+		name := strings.TrimSuffix(method.Name, "WithResponse")
+		if name == method.Name || strings.HasSuffix(name, "WithBody") {
+			continue
+		}
+		responseName, _, _ := strings.Cut(strings.TrimPrefix(method.Results, "(*"), ",")
+		response, ok := byName[responseName]
+		if !ok || response.Ambiguous {
+			continue
+		}
+		signature, callArgs, bound := bindParams(method, bindWorkspace)
+		if bindWorkspace && !bound {
+			continue
+		}
+		result = append(result, ResultMethod{
+			Name:      name,
+			Method:    method,
+			Signature: signature,
+			CallArgs:  callArgs,
+			Response:  response,
+		})
+	}
+	return result
+}
+
+// collectImports returns the standard library packages referenced by the generated signatures.
+func collectImports(methods []Method) []string {
+	imports := map[string]bool{}
+	for _, method := range methods {
+		for _, param := range method.Params {
+			if pkg, _, found := strings.Cut(strings.TrimPrefix(param.Type, "..."), "."); found {
+				imports[strings.TrimLeft(pkg, "*[]")] = true
+			}
+		}
+	}
+	var result []string
+	for pkg := range imports {
+		result = append(result, pkg)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func fieldListString(fset *token.FileSet, fields *ast.FieldList) string {
+	if fields == nil {
+		return ""
+	}
+	var parts []string
+	for _, field := range fields.List {
+		parts = append(parts, nodeString(fset, field.Type))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func nodeString(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, fset, node)
+	return buf.String()
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+type VersionData struct {
+	Version  string
+	SpecHash string
+}
+
+type versionConfig struct {
+	Version string `json:"version"`
+}
+
+func main() {
+	if len(os.Args) < 4 {
+		fmt.Println("Usage: go run generate-version.go <version-config> <openapi-file> <output-file>")
+		fmt.Println("Example: go run generate-version.go ../configs/version.json ../configs/openapi.json ../../sdk/constants/version.gen.go")
+		os.Exit(1)
+	}
+
+	versionFile := os.Args[1]
+	specFile := os.Args[2]
+	outputFile := os.Args[3]
+
+	content, err := os.ReadFile(versionFile)
+	if err != nil {
+		fmt.Printf("Error reading version config: %v\n", err)
+		os.Exit(1)
+	}
+	var config versionConfig
+	if err := json.Unmarshal(content, &config); err != nil || config.Version == "" {
+		fmt.Printf("Error parsing version config: %v\n", err)
+		os.Exit(1)
+	}
+
+	spec, err := os.ReadFile(specFile)
+	if err != nil {
+		fmt.Printf("Error reading OpenAPI spec: %v\n", err)
+		os.Exit(1)
+	}
+	sum := sha256.Sum256(spec)
+
+	// Read the template file
+	templatePath := filepath.Join("..", "templates", "version.go.tmpl")
+	tmplContent, err := os.ReadFile(templatePath)
+	if err != nil {
+		fmt.Printf("Error reading template file: %v\n", err)
+		os.Exit(1)
+	}
+
+	tmpl, err := template.New("version").Parse(string(tmplContent))
+	if err != nil {
+		fmt.Printf("Error parsing template: %v\n", err)
+		os.Exit(1)
+	}
+
+	file, err := os.Create(outputFile)
+	if err != nil {
+		fmt.Printf("Error creating output file: %v\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	data := VersionData{
+		Version:  config.Version,
+		SpecHash: hex.EncodeToString(sum[:])[:12],
+	}
+	if err := tmpl.Execute(file, data); err != nil {
+		fmt.Printf("Error executing template: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("✓ Generated version file: %s (%s, spec %s)\n", outputFile, data.Version, data.SpecHash)
+}
@@ -50,4 +50,7 @@ fi
 echo "Generating SDK..."
 ./create-sdk.sh ../configs/sub ../../sdk/core
 
+echo "Stamping SDK version..."
+go run generate-version.go ../configs/version.json ../configs/openapi.json ../../sdk/constants/version.gen.go
+
 echo "Done! SDK has been generated successfully."
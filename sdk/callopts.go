@@ -0,0 +1,50 @@
+package sotton
+
+import (
+	"context"
+	"time"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
+)
+
+// CallOption overrides the behavior of a single call, see package callopts.
+type CallOption = callopts.CallOption
+
+// WithCallOptions returns a context carrying per-call overrides honored by the built-in interceptors:
+//
+//	ctx = sotton.WithCallOptions(ctx, sotton.NoRetry(), sotton.Header("X-Foo", "1"))
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	return callopts.WithCallOptions(ctx, opts...)
+}
+
+func NoRetry() CallOption {
+	return callopts.NoRetry()
+}
+
+func MaxRetries(maxRetries int) CallOption {
+	return callopts.MaxRetries(maxRetries)
+}
+
+func Timeout(timeout time.Duration) CallOption {
+	return callopts.Timeout(timeout)
+}
+
+func Header(key, value string) CallOption {
+	return callopts.Header(key, value)
+}
+
+func SkipLogging() CallOption {
+	return callopts.SkipLogging()
+}
+
+func NoCache() CallOption {
+	return callopts.NoCache()
+}
+
+func IdempotencyKey(key string) CallOption {
+	return callopts.IdempotencyKey(key)
+}
+
+func AllowUnsafeRetry() CallOption {
+	return callopts.AllowUnsafeRetry()
+}
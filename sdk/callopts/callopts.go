@@ -0,0 +1,113 @@
+// Package callopts carries per-call overrides in a context.Context.
+// The built-in interceptors read them with FromContext:
+//
+//	ctx = callopts.WithCallOptions(ctx, callopts.NoRetry(), callopts.Header("X-Foo", "1"))
+//	resp, err := sdk.Iam_v1.ListGroupsWithResponse(ctx, workspaceUUID)
+package callopts
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type contextKey struct{}
+
+// Options are the overrides of a single call. The zero value changes nothing.
+type Options struct {
+	// NoRetry disables retries.
+	NoRetry bool
+	// MaxRetries overrides the retry decider's maximum. Zero keeps the decider's value.
+	MaxRetries int
+	// Timeout bounds the whole call, including retries. Zero means no extra timeout.
+	Timeout time.Duration
+	// Headers are set on the request before the interceptor chain runs.
+	Headers http.Header
+	// SkipLogging turns logging interceptors off.
+	SkipLogging bool
+	// NoCache makes caching interceptors bypass their cache.
+	NoCache bool
+	// IdempotencyKey is sent as the Idempotency-Key header of non-idempotent calls instead of
+	// a generated one, e.g. to keep the key across retries done by the application.
+	IdempotencyKey string
+	// AllowUnsafeRetry lets retries resend non-idempotent calls that carry no Idempotency-Key.
+	AllowUnsafeRetry bool
+}
+
+type CallOption func(*Options)
+
+// WithCallOptions returns a context carrying the options already in ctx plus opts.
+func WithCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	options := FromContext(ctx)
+	options.Headers = options.Headers.Clone()
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return context.WithValue(ctx, contextKey{}, options)
+}
+
+// FromContext returns the options stored in ctx. Headers must not be modified.
+func FromContext(ctx context.Context) Options {
+	if ctx == nil {
+		return Options{}
+	}
+	options, _ := ctx.Value(contextKey{}).(Options)
+	return options
+}
+
+func NoRetry() CallOption {
+	return func(o *Options) {
+		o.NoRetry = true
+	}
+}
+
+// MaxRetries sets the maximum number of retries. Values below one disable retries.
+func MaxRetries(maxRetries int) CallOption {
+	return func(o *Options) {
+		if maxRetries < 1 {
+			o.NoRetry = true
+			return
+		}
+		o.MaxRetries = maxRetries
+	}
+}
+
+func Timeout(timeout time.Duration) CallOption {
+	return func(o *Options) {
+		o.Timeout = timeout
+	}
+}
+
+func Header(key, value string) CallOption {
+	return func(o *Options) {
+		if o.Headers == nil {
+			o.Headers = http.Header{}
+		}
+		o.Headers.Set(key, value)
+	}
+}
+
+func SkipLogging() CallOption {
+	return func(o *Options) {
+		o.SkipLogging = true
+	}
+}
+
+func NoCache() CallOption {
+	return func(o *Options) {
+		o.NoCache = true
+	}
+}
+
+func IdempotencyKey(key string) CallOption {
+	return func(o *Options) {
+		o.IdempotencyKey = key
+	}
+}
+
+// AllowUnsafeRetry accepts that a retried non-idempotent call may be performed twice.
+func AllowUnsafeRetry() CallOption {
+	return func(o *Options) {
+		o.AllowUnsafeRetry = true
+	}
+}
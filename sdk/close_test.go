@@ -0,0 +1,123 @@
+package sotton
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/goleak"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+// ticker is an interceptor with background work, stopped by Close.
+type ticker struct {
+	stop    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+}
+
+func newTicker() *ticker {
+	t := &ticker{stop: make(chan struct{}), stopped: make(chan struct{})}
+	go func() {
+		defer close(t.stopped)
+		tick := time.NewTicker(time.Millisecond)
+		defer tick.Stop()
+		for {
+			select {
+			case <-tick.C:
+			case <-t.stop:
+				return
+			}
+		}
+	}()
+	return t
+}
+
+func (t *ticker) BeforeRequest(data interceptors.InterceptorData) (interceptors.InterceptorData, error) {
+	return data, nil
+}
+
+func (t *ticker) AfterResponse(data interceptors.InterceptorData) (interceptors.InterceptorData, error) {
+	return data, nil
+}
+
+func (t *ticker) Close() error {
+	t.once.Do(func() { close(t.stop) })
+	<-t.stopped
+	return nil
+}
+
+func newServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("[]"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestCloseLeavesNoGoroutines(t *testing.T) {
+	srv := newServer(t)
+	ignore := goleak.IgnoreCurrent()
+
+	sdk, err := NewSDK("secret",
+		WithServerAddress(srv.URL),
+		WithTLSConfig(&tls.Config{}), // the SDK builds its own transport
+		WithInterceptor(newTicker()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sdk.Iam_v1.ListDetailedGroupsWithResponse(context.Background(), "workspace"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sdk.Close(); err != nil {
+		t.Fatal(err)
+	}
+	goleak.VerifyNone(t, ignore)
+
+	_, err = sdk.Iam_v1.ListDetailedGroupsWithResponse(context.Background(), "workspace")
+	if !errors.Is(err, constants.ErrClientClosed) {
+		t.Errorf("err = %v, want ErrClientClosed", err)
+	}
+}
+
+func TestCloseLeavesSharedTransport(t *testing.T) {
+	srv := newServer(t)
+	closed, err := NewSDK("secret", WithServerAddress(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	open, err := NewSDK("secret", WithServerAddress(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer open.Close()
+
+	if _, err := open.Iam_v1.ListDetailedGroupsWithResponse(context.Background(), "workspace"); err != nil {
+		t.Fatal(err)
+	}
+	if err := closed.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var reused bool
+	ctx := httptrace.WithClientTrace(context.Background(), &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) { reused = info.Reused },
+	})
+	if _, err := open.Iam_v1.ListDetailedGroupsWithResponse(ctx, "workspace"); err != nil {
+		t.Fatal(err)
+	}
+	if !reused {
+		t.Error("closing one SDK dropped the idle connections of another")
+	}
+}
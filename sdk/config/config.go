@@ -0,0 +1,240 @@
+// Package config loads named Sotoon profiles from a YAML or JSON configuration file.
+//
+// A configuration file looks like:
+//
+//	default_profile: prod
+//	profiles:
+//	  prod:
+//	    region: ir
+//	    workspace: 3fa85f64-5717-4562-b3fc-2c963f66afa6
+//	    credentials:
+//	      secret_key_env: SOTOON_PROD_KEY
+//	    timeout: 30s
+//	    retry:
+//	      max_retries: 3
+//	      base_delay: 200ms
+//	      max_delay: 5s
+//	  staging:
+//	    endpoint: https://api.staging.example
+//	    credentials:
+//	      file: ~/.sotoon/credentials
+//	      profile: staging
+//	    logging:
+//	      basic: true
+//
+// JSON files use the same field names.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvConfigFile overrides the location of the configuration file.
+	EnvConfigFile = "SOTOON_CONFIG_FILE"
+
+	EnvAPIURL     = "SOTOON_API_URL"
+	EnvRegion     = "SOTOON_REGION"
+	EnvWorkspace  = "SOTOON_WORKSPACE"
+	EnvTimeout    = "SOTOON_TIMEOUT"
+	EnvMaxRetries = "SOTOON_MAX_RETRIES"
+)
+
+// Config is a parsed configuration file.
+type Config struct {
+	DefaultProfile string
+	profiles       map[string]rawProfile
+}
+
+// Profile is a validated profile with environment overrides applied.
+type Profile struct {
+	Name             string
+	Endpoint         string
+	Region           string
+	ServiceEndpoints map[string]string
+	Credentials      Credentials
+	Workspace        string
+	Timeout          time.Duration
+	Retry            *RetrySettings
+	Logging          *LoggingSettings
+}
+
+// Credentials tells where the secret key of a profile comes from.
+// At most one of SecretKey, SecretKeyEnv and File may be set; none means the default chain.
+type Credentials struct {
+	SecretKey    string
+	SecretKeyEnv string
+	File         string
+	Profile      string
+}
+
+type RetrySettings struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+type LoggingSettings struct {
+	Basic       bool
+	Headers     bool
+	Body        bool
+	MaxBodySize int
+}
+
+type rawFile struct {
+	DefaultProfile string                `yaml:"default_profile"`
+	Profiles       map[string]rawProfile `yaml:"profiles"`
+}
+
+type rawProfile struct {
+	Endpoint         string            `yaml:"endpoint"`
+	Region           string            `yaml:"region"`
+	ServiceEndpoints map[string]string `yaml:"service_endpoints"`
+	Credentials      rawCredentials    `yaml:"credentials"`
+	Workspace        string            `yaml:"workspace"`
+	Timeout          string            `yaml:"timeout"`
+	Retry            *rawRetry         `yaml:"retry"`
+	Logging          *rawLogging       `yaml:"logging"`
+}
+
+type rawCredentials struct {
+	SecretKey    string `yaml:"secret_key"`
+	SecretKeyEnv string `yaml:"secret_key_env"`
+	File         string `yaml:"file"`
+	Profile      string `yaml:"profile"`
+}
+
+type rawRetry struct {
+	MaxRetries *int   `yaml:"max_retries"`
+	BaseDelay  string `yaml:"base_delay"`
+	MaxDelay   string `yaml:"max_delay"`
+}
+
+type rawLogging struct {
+	Basic       bool `yaml:"basic"`
+	Headers     bool `yaml:"headers"`
+	Body        bool `yaml:"body"`
+	MaxBodySize int  `yaml:"max_body_size"`
+}
+
+// DefaultPath returns SOTOON_CONFIG_FILE or ~/.sotoon/config.yaml.
+func DefaultPath() string {
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".sotoon", "config.yaml")
+}
+
+// Load reads the configuration file at path. An empty path means DefaultPath().
+func Load(path string) (*Config, error) {
+	if path == "" {
+		path = DefaultPath()
+	}
+	content, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return nil, err
+	}
+	config, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Parse parses a YAML or JSON configuration. Unknown fields are rejected.
+func Parse(content []byte) (*Config, error) {
+	var file rawFile
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if file.DefaultProfile != "" {
+		if _, ok := file.Profiles[file.DefaultProfile]; !ok {
+			return nil, ValidationErrors{{Field: "default_profile", Message: fmt.Sprintf("profile %q is not defined", file.DefaultProfile)}}
+		}
+	}
+	return &Config{
+		DefaultProfile: file.DefaultProfile,
+		profiles:       file.Profiles,
+	}, nil
+}
+
+// Profiles returns the names of all profiles, sorted.
+func (c *Config) Profiles() []string {
+	names := make([]string, 0, len(c.profiles))
+	for name := range c.profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Profile returns the named profile with environment overrides applied.
+// An empty name means SOTOON_PROFILE, then default_profile, then "default".
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(interceptors.EnvProfile)
+	}
+	if name == "" {
+		name = c.DefaultProfile
+	}
+	if name == "" {
+		name = interceptors.DefaultProfile
+	}
+
+	raw, ok := c.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found", name)
+	}
+	return raw.resolve(name)
+}
+
+// LoadProfile loads the default configuration file and returns the named profile.
+func LoadProfile(name string) (*Profile, error) {
+	config, err := Load("")
+	if err != nil {
+		return nil, err
+	}
+	return config.Profile(name)
+}
+
+// CredentialsProvider builds the provider described by the profile.
+func (p *Profile) CredentialsProvider() interceptors.CredentialsProvider {
+	switch {
+	case p.Credentials.SecretKey != "":
+		return interceptors.NewStaticCredentials(p.Credentials.SecretKey)
+	case p.Credentials.SecretKeyEnv != "":
+		return interceptors.NewEnvVarCredentials(p.Credentials.SecretKeyEnv)
+	case p.Credentials.File != "" || p.Credentials.Profile != "":
+		return interceptors.NewFileCredentials(expandHome(p.Credentials.File), p.Credentials.Profile)
+	default:
+		return interceptors.NewDefaultCredentialsChain()
+	}
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
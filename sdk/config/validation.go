@@ -0,0 +1,203 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+var knownRegions = map[string]bool{"ir": true, "io": true}
+
+// ValidationError reports an invalid field. Field is the path of the field in the
+// file (e.g. "profiles.prod.timeout") or the environment variable that set it.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors holds every problem found in a profile.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return "invalid configuration: " + strings.Join(messages, "; ")
+}
+
+// profileResolver applies environment overrides to a raw profile and validates it,
+// remembering which fields came from the environment for error messages.
+type profileResolver struct {
+	name    string
+	sources map[string]string
+	errs    ValidationErrors
+}
+
+func (r rawProfile) resolve(name string) (*Profile, error) {
+	resolver := &profileResolver{name: name, sources: map[string]string{}}
+	resolver.applyEnv(&r)
+
+	profile := &Profile{
+		Name:             name,
+		Endpoint:         r.Endpoint,
+		Region:           r.Region,
+		ServiceEndpoints: r.ServiceEndpoints,
+		Credentials: Credentials{
+			SecretKey:    r.Credentials.SecretKey,
+			SecretKeyEnv: r.Credentials.SecretKeyEnv,
+			File:         r.Credentials.File,
+			Profile:      r.Credentials.Profile,
+		},
+		Workspace: r.Workspace,
+		Timeout:   resolver.duration("timeout", r.Timeout),
+	}
+
+	if r.Endpoint != "" {
+		resolver.url("endpoint", r.Endpoint)
+	}
+	if r.Endpoint != "" && r.Region != "" {
+		resolver.fail("region", "cannot be combined with endpoint")
+	}
+	if r.Region != "" && !knownRegions[r.Region] {
+		resolver.fail("region", fmt.Sprintf("unknown region %q, expected ir or io", r.Region))
+	}
+	for service, endpoint := range r.ServiceEndpoints {
+		resolver.url("service_endpoints."+service, endpoint)
+	}
+	if r.Workspace != "" {
+		if _, err := uuid.Parse(r.Workspace); err != nil {
+			resolver.fail("workspace", fmt.Sprintf("%q is not a valid UUID", r.Workspace))
+		}
+	}
+
+	sources := 0
+	for _, set := range []bool{r.Credentials.SecretKey != "", r.Credentials.SecretKeyEnv != "", r.Credentials.File != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		resolver.fail("credentials", "only one of secret_key, secret_key_env and file may be set")
+	}
+	if r.Credentials.Profile != "" && (r.Credentials.SecretKey != "" || r.Credentials.SecretKeyEnv != "") {
+		resolver.fail("credentials.profile", "can only be used with a credentials file")
+	}
+
+	if r.Retry != nil {
+		profile.Retry = &RetrySettings{
+			BaseDelay: resolver.duration("retry.base_delay", r.Retry.BaseDelay),
+			MaxDelay:  resolver.duration("retry.max_delay", r.Retry.MaxDelay),
+		}
+		if r.Retry.MaxRetries != nil {
+			profile.Retry.MaxRetries = *r.Retry.MaxRetries
+		}
+		if profile.Retry.MaxRetries < 0 {
+			resolver.fail("retry.max_retries", "must not be negative")
+		}
+		if profile.Retry.MaxDelay != 0 && profile.Retry.MaxDelay < profile.Retry.BaseDelay {
+			resolver.fail("retry.max_delay", "must not be smaller than base_delay")
+		}
+	}
+
+	if r.Logging != nil {
+		profile.Logging = &LoggingSettings{
+			Basic:       r.Logging.Basic,
+			Headers:     r.Logging.Headers,
+			Body:        r.Logging.Body,
+			MaxBodySize: r.Logging.MaxBodySize,
+		}
+		if r.Logging.MaxBodySize < 0 {
+			resolver.fail("logging.max_body_size", "must not be negative")
+		}
+	}
+
+	if len(resolver.errs) > 0 {
+		return nil, resolver.errs
+	}
+	return profile, nil
+}
+
+// applyEnv overrides profile fields with the SOTOON_* environment variables that are set.
+func (p *profileResolver) applyEnv(r *rawProfile) {
+	if value, ok := lookupEnv(EnvAPIURL); ok {
+		r.Endpoint, r.Region = value, ""
+		p.sources["endpoint"] = EnvAPIURL
+	}
+	if value, ok := lookupEnv(EnvRegion); ok {
+		r.Region, r.Endpoint = value, ""
+		p.sources["region"] = EnvRegion
+	}
+	if value, ok := lookupEnv(interceptors.EnvSecretKey); ok {
+		r.Credentials = rawCredentials{SecretKey: value}
+	}
+	if value, ok := lookupEnv(EnvWorkspace); ok {
+		r.Workspace = value
+		p.sources["workspace"] = EnvWorkspace
+	}
+	if value, ok := lookupEnv(EnvTimeout); ok {
+		r.Timeout = value
+		p.sources["timeout"] = EnvTimeout
+	}
+	if value, ok := lookupEnv(EnvMaxRetries); ok {
+		maxRetries, err := strconv.Atoi(value)
+		if err != nil {
+			p.errs = append(p.errs, ValidationError{Field: EnvMaxRetries, Message: fmt.Sprintf("%q is not an integer", value)})
+			return
+		}
+		if r.Retry == nil {
+			r.Retry = &rawRetry{}
+		} else {
+			retry := *r.Retry
+			r.Retry = &retry
+		}
+		r.Retry.MaxRetries = &maxRetries
+		p.sources["retry.max_retries"] = EnvMaxRetries
+	}
+}
+
+func (p *profileResolver) fail(field, message string) {
+	name, ok := p.sources[field]
+	if !ok {
+		name = "profiles." + p.name + "." + field
+	}
+	p.errs = append(p.errs, ValidationError{Field: name, Message: message})
+}
+
+func (p *profileResolver) duration(field, value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		p.fail(field, fmt.Sprintf("%q is not a valid duration", value))
+		return 0
+	}
+	if d < 0 {
+		p.fail(field, "must not be negative")
+		return 0
+	}
+	return d
+}
+
+func (p *profileResolver) url(field, value string) {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		p.fail(field, fmt.Sprintf("%q is not an absolute URL", value))
+	}
+}
+
+func lookupEnv(name string) (string, bool) {
+	value := strings.TrimSpace(os.Getenv(name))
+	return value, value != ""
+}
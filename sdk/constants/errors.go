@@ -3,6 +3,20 @@ package constants
 import "errors"
 
 var (
-	ErrMaxRetriesExceeded = errors.New("max retries exceeded")
-	ErrCircuitBreakerOpen    = errors.New("circuit breaker is open")
+	ErrMaxRetriesExceeded  = errors.New("max retries exceeded")
+	ErrCircuitBreakerOpen  = errors.New("circuit breaker is open")
+	ErrNoCredentials       = errors.New("no credentials found")
+	ErrClientClosed        = errors.New("client is closed")
+	ErrInterceptorNotFound = errors.New("interceptor not found")
+)
+
+// Sentinels matched by errors.Is against the *interceptors.APIError of a failed call,
+// according to its status code.
+var (
+	ErrUnauthorized = errors.New("unauthorized") // 401
+	ErrForbidden    = errors.New("forbidden")    // 403
+	ErrNotFound     = errors.New("not found")    // 404
+	ErrConflict     = errors.New("conflict")     // 409
+	ErrRateLimited  = errors.New("rate limited") // 429
+	ErrServerError  = errors.New("server error") // 5xx
 )
@@ -0,0 +1,38 @@
+package constants
+
+// RedactedValue replaces redacted header values and body fields in logs.
+const RedactedValue = "[REDACTED]"
+
+// SensitiveHeaders are redacted from logs unless redaction is disabled.
+var SensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Key",
+}
+
+// SensitiveFields are JSON field names redacted from logged bodies of every call.
+var SensitiveFields = []string{
+	"password",
+	"secret",
+	"secret_key",
+	"client_secret",
+	"access_token",
+	"refresh_token",
+	"id_token",
+	"token",
+	"verification_code",
+	"private_key",
+}
+
+// SensitiveFieldSuffixes mark further fields as sensitive: the generator lists every string
+// field of a service's schemas whose JSON name ends with one of them (e.g. "invitation_token",
+// "new_password") in the SensitiveFields of its operations.
+var SensitiveFieldSuffixes = []string{
+	"password",
+	"secret",
+	"secret_key",
+	"token",
+	"private_key",
+}
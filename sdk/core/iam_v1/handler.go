@@ -1,13 +1,20 @@
 package iam_v1
 
 import (
-	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+	"crypto/tls"
+	"errors"
 	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
 )
 
 type Handler struct {
 	*ClientWithResponses
+	httpClient           *http.Client
 	interceptorTransport *interceptors.InterceptorTransport
+	err                  error
 }
 
 type HandlerOption func(*Handler) *Handler
@@ -19,13 +26,110 @@ func WithInterceptor(interceptors ...interceptors.Interceptor) HandlerOption {
 	}
 }
 
+// WithChainEdits applies the edits to the interceptor chain in order.
+func WithChainEdits(edits ...interceptors.ChainEdit) HandlerOption {
+	return func(handler *Handler) *Handler {
+		for _, edit := range edits {
+			if err := edit(handler.interceptorTransport); err != nil {
+				handler.err = errors.Join(handler.err, err)
+			}
+		}
+		return handler
+	}
+}
+
+// WithPrependedInterceptor puts the interceptors in front of the chain, before the defaults.
+func WithPrependedInterceptor(added ...interceptors.Interceptor) HandlerOption {
+	return WithChainEdits(interceptors.PrependInterceptors(added...))
+}
+
+// WithInterceptorBefore puts the interceptors right before the interceptor called name.
+func WithInterceptorBefore(name string, added ...interceptors.Interceptor) HandlerOption {
+	return WithChainEdits(interceptors.InsertInterceptorsBefore(name, added...))
+}
+
+// WithInterceptorAfter puts the interceptors right after the interceptor called name.
+func WithInterceptorAfter(name string, added ...interceptors.Interceptor) HandlerOption {
+	return WithChainEdits(interceptors.InsertInterceptorsAfter(name, added...))
+}
+
+// WithoutInterceptor removes the interceptor called name, e.g. interceptors.AuthenticatorName.
+func WithoutInterceptor(name string) HandlerOption {
+	return WithChainEdits(interceptors.RemoveInterceptor(name))
+}
+
+// WithReplacedInterceptor swaps the interceptor called name for replacement.
+func WithReplacedInterceptor(name string, replacement interceptors.Interceptor) HandlerOption {
+	return WithChainEdits(interceptors.ReplaceInterceptor(name, replacement))
+}
+
+// WithMiddleware wraps the sending of each request in the middlewares, inside the interceptor chain.
+func WithMiddleware(middlewares ...interceptors.Middleware) HandlerOption {
+	return WithChainEdits(interceptors.AppendMiddlewares(middlewares...))
+}
+
+// WithHTTPTransport sets the RoundTripper that finally sends the requests.
+func WithHTTPTransport(rt http.RoundTripper) HandlerOption {
+	return func(handler *Handler) *Handler {
+		if rt != nil {
+			handler.interceptorTransport.SetTransport(rt)
+		}
+		return handler
+	}
+}
+
+// WithAppInfo appends the application name and version to the User-Agent header. It fails
+// with constants.ErrInterceptorNotFound when the User-Agent interceptor was removed.
+func WithAppInfo(name, version string) HandlerOption {
+	return WithChainEdits(interceptors.AddAppInfo(name, version))
+}
+
+// WithTimeout sets the overall timeout of each call, including retries done by interceptors.
+func WithTimeout(timeout time.Duration) HandlerOption {
+	return func(handler *Handler) *Handler {
+		handler.httpClient.Timeout = timeout
+		return handler
+	}
+}
+
+// WithTimeoutPolicy sets default and per-operation deadlines, total and per attempt, on calls.
+func WithTimeoutPolicy(policy interceptors.TimeoutPolicy) HandlerOption {
+	return WithChainEdits(interceptors.ApplyTimeoutPolicy(policy))
+}
+
+// WithTLSConfig sets the TLS configuration (CA bundle, client certificates, ...) of the underlying transport.
+func WithTLSConfig(config *tls.Config) HandlerOption {
+	return func(handler *Handler) *Handler {
+		return handler.configureHTTPTransport(func(t *http.Transport) {
+			t.TLSClientConfig = config
+		})
+	}
+}
+
+// WithProxy sets the proxy function of the underlying transport, e.g. http.ProxyURL(u).
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) HandlerOption {
+	return func(handler *Handler) *Handler {
+		return handler.configureHTTPTransport(func(t *http.Transport) {
+			t.Proxy = proxy
+		})
+	}
+}
+
 func NewHandler(serverAddress, secretKey string, opts ...HandlerOption) (*Handler, error) {
-	interceptorTransport := interceptors.NewDefaultInterceptorTransport(secretKey)
+	return NewHandlerWithCredentials(serverAddress, interceptors.NewStaticCredentials(secretKey), opts...)
+}
+
+// NewHandlerWithCredentials is like NewHandler but asks credentials for the secret key on every request.
+func NewHandlerWithCredentials(serverAddress string, credentials interceptors.CredentialsProvider, opts ...HandlerOption) (*Handler, error) {
+	interceptorTransport := interceptors.NewDefaultInterceptorTransportWithCredentials(credentials)
+	interceptorTransport.UserAgent().SetService(ServiceName)
+	interceptorTransport.RegisterOperations(Operations...)
+	httpClient := &http.Client{
+		Transport: interceptorTransport,
+	}
 	client, err := NewClientWithResponses(
 		serverAddress,
-		WithHTTPClient(&http.Client{
-			Transport: interceptorTransport,
-		}))
+		WithHTTPClient(httpClient))
 
 	if err != nil {
 		return nil, err
@@ -33,14 +137,38 @@ func NewHandler(serverAddress, secretKey string, opts ...HandlerOption) (*Handle
 
 	handler := &Handler{
 		ClientWithResponses:  client,
+		httpClient:           httpClient,
 		interceptorTransport: interceptorTransport,
 	}
 	for _, opt := range opts {
 		handler = opt(handler)
 	}
+	if handler.err != nil {
+		return nil, handler.err
+	}
 	return handler, nil
 }
 
 func (h *Handler) AddInterceptors(interceptors ...interceptors.Interceptor) {
 	h.interceptorTransport.AddInterceptors(interceptors...)
 }
+
+// Interceptors returns the handler's interceptor chain for inspection (List) and editing.
+func (h *Handler) Interceptors() *interceptors.InterceptorTransport {
+	return h.interceptorTransport
+}
+
+// Close stops the background work of the handler's interceptors and releases the idle
+// connections of a transport built by WithTLSConfig or WithProxy; the shared transport and
+// the one passed to WithHTTPTransport are left alone. Calls made after Close fail with
+// constants.ErrClientClosed.
+func (h *Handler) Close() error {
+	return h.interceptorTransport.Close()
+}
+
+func (h *Handler) configureHTTPTransport(configure func(*http.Transport)) *Handler {
+	if err := h.interceptorTransport.ConfigureHTTPTransport(configure); err != nil {
+		h.err = errors.Join(h.err, err)
+	}
+	return h
+}
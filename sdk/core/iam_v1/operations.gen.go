@@ -0,0 +1,114 @@
+// Code generated by generate-extensions.go. DO NOT EDIT.
+
+package iam_v1
+
+import "github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+
+// Operations lists every operation of the service. Handlers register them with their
+// transport, so interceptors can tell calls apart with interceptors.OperationFromContext.
+var Operations = []interceptors.Operation{
+	{Service: ServiceName, Name: "AcceptInvitation", Method: "POST", PathTemplate: "/iam/v1/api/v1/accept-invitation/{token}/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "AddRuleToRole", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/rule/{ruleUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "AddServiceUserToGroup", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/service-user/{serviceUserUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "AddUserToGroup", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/user/{userUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "AllowUser", Method: "PUT", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/user/{userUUID}/allow/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "AssignRoleToServiceUser", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/service-user/{serviceUserUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "BulkAddRolesToGroup", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/bulk-add-roles/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "BulkAddRulesToRole", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/bulk-add-rules/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "BulkAddServiceUsersToGroup", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/bulk-add-service-users/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "BulkAddServiceUsersToRole", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/bulk-add-service-users/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "BulkAddUsersToGroup", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/bulk-add-users/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "BulkAddUsersToRole", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/bulk-add-users/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "BulkCanUser", Method: "POST", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/bulk-can/workspace/{workspaceUUID}", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "BulkRefreshThirdPartyTokens", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/third-parties/{thirdPartyUUID}/service-users/{serviceUserUUID}/bulk-refresh-tokens", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ChangePassword", Method: "POST", PathTemplate: "/iam/v1/api/v1/user/change-password/{token}/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateAuthTokenWithChallenge", Method: "POST", PathTemplate: "/iam/v1/api/v1/authn/challenge/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateAuthTokenWithCred", Method: "POST", PathTemplate: "/iam/v1/api/v1/authn/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateBackupKey", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/backup-key/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateGroup", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateRole", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateRule", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/rule/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateServiceUser", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateServiceUserKiseKey", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/kise/key/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateServiceUserPublicKey", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/service-user-public-key/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateServiceUserToken", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/token/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateUserKiseKey", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/user/{userUUID}/kise/key/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateUserPublicKey", Method: "POST", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/public-key/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "CreateUserToken", Method: "POST", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/user-token/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteBackupKey", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/backup-key/{resourceUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteGroup", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteRole", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteRule", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/rule/{ruleUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteServiceUser", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteServiceUserKiseKey", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/kise/key/{resourceUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteServiceUserPublicKey", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/service-user-public-key/{resourceUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteServiceUserToken", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/token/{resourceUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteUserKiseKey", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/user/{userUUID}/kise/key/{resourceUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteUserPublicKey", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/public-key/{resourceId}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DeleteUserToken", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/user-token/{resourceUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "DisableUserOtp", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/otp/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "EnableUserOtp", Method: "POST", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/otp/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetDetailedGroup", Method: "GET", PathTemplate: "/iam/v1/api/v1/detailed/workspace/{workspaceUUID}/group/{groupUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetDetailedServiceUser", Method: "GET", PathTemplate: "/iam/v1/api/v1/detailed/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetDetailedWorkspaceUser", Method: "GET", PathTemplate: "/iam/v1/api/v1/detailed/workspace/{workspaceUUID}/user/{userUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetGroup", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetIamV1ApiV1Healthz", Method: "GET", PathTemplate: "/iam/v1/api/v1/healthz/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetOpenIdToken", Method: "POST", PathTemplate: "/iam/v1/openid/token/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetRole", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetRule", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/rule/{ruleUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetThirdPartyAccessToken", Method: "POST", PathTemplate: "/iam/v1/api/v1/organizations/{organizationUUID}/third-parties/{thirdPartyUUID}/access-tokens", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetUser", Method: "GET", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "GetUserOtpStatus", Method: "GET", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/otp/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "InviteUsersToWorkspace", Method: "POST", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/invite/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListBackupKeys", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/backup-key/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListDetailedGroups", Method: "GET", PathTemplate: "/iam/v1/api/v1/detailed/workspace/{workspaceUUID}/group/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListDetailedServiceUsers", Method: "GET", PathTemplate: "/iam/v1/api/v1/detailed/workspace/{workspaceUUID}/service-user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListDetailedWorkspaceUsers", Method: "GET", PathTemplate: "/iam/v1/api/v1/detailed/workspace/{workspaceUUID}/user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListGroupRoles", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/role/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListGroupServiceUsers", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/service-user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListGroupUsers", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListGroups", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListRoleRules", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/rule/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListRoleUsers", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListRoles", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListRolesServiceUsers", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/service-user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListRuleRoles", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/rule/{ruleUUID}/role/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListRules", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/rule/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListServiceUserKiseKeys", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/kise/key/service-user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListServiceUserPublicKeys", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/service-user-public-key/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListServiceUserTokens", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/token/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListServiceUsers", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListServices", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListUserKiseKeys", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/user/{userUUID}/kise/key/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListUserPublicKeys", Method: "GET", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/public-key/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListUserTokens", Method: "GET", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/user-token/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListUserWorkspaces", Method: "GET", PathTemplate: "/iam/v1/api/v1/user/{userUUID}/workspace/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ListWorkspaceUsers", Method: "GET", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/user/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "RemoveRoleFromGroup", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/group/{groupUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "RemoveRoleFromServiceUser", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/service-user/{serviceUserUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "RemoveRoleFromUser", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/user/{userUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "RemoveRuleFromRole", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/role/{roleUUID}/rule/{ruleUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "RemoveServiceUserFromGroup", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/service-user/{serviceUserUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "RemoveUserFromGroup", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/user/{userUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "RemoveUserFromWorkspace", Method: "DELETE", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/user/{userUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "ResetPassword", Method: "POST", PathTemplate: "/iam/v1/api/v1/user/reset-password/", Idempotent: false, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "SuspendUser", Method: "PUT", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/user/{userUUID}/suspend/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "UpdateGroup", Method: "PUT", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/group/{groupUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "UpdateRule", Method: "PUT", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/rule/{ruleUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+	{Service: ServiceName, Name: "UpdateServiceUser", Method: "PUT", PathTemplate: "/iam/v1/api/v1/workspace/{workspaceUUID}/service-user/{serviceUserUUID}/", Idempotent: true, SensitiveFields: sensitiveFields},
+}
+
+// sensitiveFields are the JSON fields of the service's schemas redacted from logged bodies.
+var sensitiveFields = []string{
+	"access_token",
+	"challenge_token",
+	"client_secret",
+	"id_token",
+	"invitation_token",
+	"password",
+	"refresh_token",
+	"secret",
+	"secret_key",
+	"token",
+	"verification_code",
+}
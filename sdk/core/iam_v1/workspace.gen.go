@@ -0,0 +1,513 @@
+// Code generated by generate-extensions.go. DO NOT EDIT.
+
+package iam_v1
+
+import (
+	"context"
+	"io"
+)
+
+// WorkspaceClient exposes the workspace-scoped operations of ClientWithResponses with
+// the workspace UUID bound, so a code path cannot mix workspaces by accident.
+type WorkspaceClient struct {
+	client        ClientWithResponsesInterface
+	workspaceUUID string
+}
+
+// Workspace returns a client whose operations all run against workspaceUUID.
+func (c *ClientWithResponses) Workspace(workspaceUUID string) *WorkspaceClient {
+	return &WorkspaceClient{
+		client:        c,
+		workspaceUUID: workspaceUUID,
+	}
+}
+
+// WorkspaceUUID returns the workspace the client is bound to.
+func (w *WorkspaceClient) WorkspaceUUID() string {
+	return w.workspaceUUID
+}
+
+// ListDetailedGroupsWithResponse calls ClientWithResponses.ListDetailedGroupsWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListDetailedGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDetailedGroupsResponse, error) {
+	return w.client.ListDetailedGroupsWithResponse(ctx, w.workspaceUUID, reqEditors...)
+}
+
+// GetDetailedGroupWithResponse calls ClientWithResponses.GetDetailedGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) GetDetailedGroupWithResponse(ctx context.Context, groupUUID string, reqEditors ...RequestEditorFn) (*GetDetailedGroupResponse, error) {
+	return w.client.GetDetailedGroupWithResponse(ctx, w.workspaceUUID, groupUUID, reqEditors...)
+}
+
+// ListDetailedServiceUsersWithResponse calls ClientWithResponses.ListDetailedServiceUsersWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListDetailedServiceUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDetailedServiceUsersResponse, error) {
+	return w.client.ListDetailedServiceUsersWithResponse(ctx, w.workspaceUUID, reqEditors...)
+}
+
+// GetDetailedServiceUserWithResponse calls ClientWithResponses.GetDetailedServiceUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) GetDetailedServiceUserWithResponse(ctx context.Context, serviceUserUUID string, reqEditors ...RequestEditorFn) (*GetDetailedServiceUserResponse, error) {
+	return w.client.GetDetailedServiceUserWithResponse(ctx, w.workspaceUUID, serviceUserUUID, reqEditors...)
+}
+
+// ListDetailedWorkspaceUsersWithResponse calls ClientWithResponses.ListDetailedWorkspaceUsersWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListDetailedWorkspaceUsersWithResponse(ctx context.Context, params *ListDetailedWorkspaceUsersParams, reqEditors ...RequestEditorFn) (*ListDetailedWorkspaceUsersResponse, error) {
+	return w.client.ListDetailedWorkspaceUsersWithResponse(ctx, w.workspaceUUID, params, reqEditors...)
+}
+
+// GetDetailedWorkspaceUserWithResponse calls ClientWithResponses.GetDetailedWorkspaceUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) GetDetailedWorkspaceUserWithResponse(ctx context.Context, userUUID string, reqEditors ...RequestEditorFn) (*GetDetailedWorkspaceUserResponse, error) {
+	return w.client.GetDetailedWorkspaceUserWithResponse(ctx, w.workspaceUUID, userUUID, reqEditors...)
+}
+
+// BulkCanUserWithBodyWithResponse calls ClientWithResponses.BulkCanUserWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkCanUserWithBodyWithResponse(ctx context.Context, userUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkCanUserResponse, error) {
+	return w.client.BulkCanUserWithBodyWithResponse(ctx, userUUID, w.workspaceUUID, contentType, body, reqEditors...)
+}
+
+// BulkCanUserWithResponse calls ClientWithResponses.BulkCanUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkCanUserWithResponse(ctx context.Context, userUUID string, body BulkCanUserJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkCanUserResponse, error) {
+	return w.client.BulkCanUserWithResponse(ctx, userUUID, w.workspaceUUID, body, reqEditors...)
+}
+
+// ListBackupKeysWithResponse calls ClientWithResponses.ListBackupKeysWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListBackupKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBackupKeysResponse, error) {
+	return w.client.ListBackupKeysWithResponse(ctx, w.workspaceUUID, reqEditors...)
+}
+
+// CreateBackupKeyWithBodyWithResponse calls ClientWithResponses.CreateBackupKeyWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateBackupKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBackupKeyResponse, error) {
+	return w.client.CreateBackupKeyWithBodyWithResponse(ctx, w.workspaceUUID, contentType, body, reqEditors...)
+}
+
+// CreateBackupKeyWithResponse calls ClientWithResponses.CreateBackupKeyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateBackupKeyWithResponse(ctx context.Context, body CreateBackupKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBackupKeyResponse, error) {
+	return w.client.CreateBackupKeyWithResponse(ctx, w.workspaceUUID, body, reqEditors...)
+}
+
+// DeleteBackupKeyWithResponse calls ClientWithResponses.DeleteBackupKeyWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteBackupKeyWithResponse(ctx context.Context, resourceUUID string, reqEditors ...RequestEditorFn) (*DeleteBackupKeyResponse, error) {
+	return w.client.DeleteBackupKeyWithResponse(ctx, w.workspaceUUID, resourceUUID, reqEditors...)
+}
+
+// ListGroupsWithResponse calls ClientWithResponses.ListGroupsWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListGroupsResponse, error) {
+	return w.client.ListGroupsWithResponse(ctx, w.workspaceUUID, reqEditors...)
+}
+
+// CreateGroupWithBodyWithResponse calls ClientWithResponses.CreateGroupWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
+	return w.client.CreateGroupWithBodyWithResponse(ctx, w.workspaceUUID, contentType, body, reqEditors...)
+}
+
+// CreateGroupWithResponse calls ClientWithResponses.CreateGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateGroupWithResponse(ctx context.Context, body CreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
+	return w.client.CreateGroupWithResponse(ctx, w.workspaceUUID, body, reqEditors...)
+}
+
+// DeleteGroupWithResponse calls ClientWithResponses.DeleteGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteGroupWithResponse(ctx context.Context, groupUUID string, reqEditors ...RequestEditorFn) (*DeleteGroupResponse, error) {
+	return w.client.DeleteGroupWithResponse(ctx, w.workspaceUUID, groupUUID, reqEditors...)
+}
+
+// GetGroupWithResponse calls ClientWithResponses.GetGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) GetGroupWithResponse(ctx context.Context, groupUUID string, reqEditors ...RequestEditorFn) (*GetGroupResponse, error) {
+	return w.client.GetGroupWithResponse(ctx, w.workspaceUUID, groupUUID, reqEditors...)
+}
+
+// UpdateGroupWithBodyWithResponse calls ClientWithResponses.UpdateGroupWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) UpdateGroupWithBodyWithResponse(ctx context.Context, groupUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupResponse, error) {
+	return w.client.UpdateGroupWithBodyWithResponse(ctx, w.workspaceUUID, groupUUID, contentType, body, reqEditors...)
+}
+
+// UpdateGroupWithResponse calls ClientWithResponses.UpdateGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) UpdateGroupWithResponse(ctx context.Context, groupUUID string, body UpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupResponse, error) {
+	return w.client.UpdateGroupWithResponse(ctx, w.workspaceUUID, groupUUID, body, reqEditors...)
+}
+
+// BulkAddRolesToGroupWithBodyWithResponse calls ClientWithResponses.BulkAddRolesToGroupWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddRolesToGroupWithBodyWithResponse(ctx context.Context, groupUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkAddRolesToGroupResponse, error) {
+	return w.client.BulkAddRolesToGroupWithBodyWithResponse(ctx, w.workspaceUUID, groupUUID, contentType, body, reqEditors...)
+}
+
+// BulkAddRolesToGroupWithResponse calls ClientWithResponses.BulkAddRolesToGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddRolesToGroupWithResponse(ctx context.Context, groupUUID string, body BulkAddRolesToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkAddRolesToGroupResponse, error) {
+	return w.client.BulkAddRolesToGroupWithResponse(ctx, w.workspaceUUID, groupUUID, body, reqEditors...)
+}
+
+// BulkAddServiceUsersToGroupWithBodyWithResponse calls ClientWithResponses.BulkAddServiceUsersToGroupWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddServiceUsersToGroupWithBodyWithResponse(ctx context.Context, groupUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkAddServiceUsersToGroupResponse, error) {
+	return w.client.BulkAddServiceUsersToGroupWithBodyWithResponse(ctx, w.workspaceUUID, groupUUID, contentType, body, reqEditors...)
+}
+
+// BulkAddServiceUsersToGroupWithResponse calls ClientWithResponses.BulkAddServiceUsersToGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddServiceUsersToGroupWithResponse(ctx context.Context, groupUUID string, body BulkAddServiceUsersToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkAddServiceUsersToGroupResponse, error) {
+	return w.client.BulkAddServiceUsersToGroupWithResponse(ctx, w.workspaceUUID, groupUUID, body, reqEditors...)
+}
+
+// BulkAddUsersToGroupWithBodyWithResponse calls ClientWithResponses.BulkAddUsersToGroupWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddUsersToGroupWithBodyWithResponse(ctx context.Context, groupUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkAddUsersToGroupResponse, error) {
+	return w.client.BulkAddUsersToGroupWithBodyWithResponse(ctx, w.workspaceUUID, groupUUID, contentType, body, reqEditors...)
+}
+
+// BulkAddUsersToGroupWithResponse calls ClientWithResponses.BulkAddUsersToGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddUsersToGroupWithResponse(ctx context.Context, groupUUID string, body BulkAddUsersToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkAddUsersToGroupResponse, error) {
+	return w.client.BulkAddUsersToGroupWithResponse(ctx, w.workspaceUUID, groupUUID, body, reqEditors...)
+}
+
+// ListGroupRolesWithResponse calls ClientWithResponses.ListGroupRolesWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListGroupRolesWithResponse(ctx context.Context, groupUUID string, reqEditors ...RequestEditorFn) (*ListGroupRolesResponse, error) {
+	return w.client.ListGroupRolesWithResponse(ctx, w.workspaceUUID, groupUUID, reqEditors...)
+}
+
+// ListGroupServiceUsersWithResponse calls ClientWithResponses.ListGroupServiceUsersWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListGroupServiceUsersWithResponse(ctx context.Context, groupUUID string, reqEditors ...RequestEditorFn) (*ListGroupServiceUsersResponse, error) {
+	return w.client.ListGroupServiceUsersWithResponse(ctx, w.workspaceUUID, groupUUID, reqEditors...)
+}
+
+// RemoveServiceUserFromGroupWithResponse calls ClientWithResponses.RemoveServiceUserFromGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) RemoveServiceUserFromGroupWithResponse(ctx context.Context, groupUUID string, serviceUserUUID string, reqEditors ...RequestEditorFn) (*RemoveServiceUserFromGroupResponse, error) {
+	return w.client.RemoveServiceUserFromGroupWithResponse(ctx, w.workspaceUUID, groupUUID, serviceUserUUID, reqEditors...)
+}
+
+// AddServiceUserToGroupWithBodyWithResponse calls ClientWithResponses.AddServiceUserToGroupWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) AddServiceUserToGroupWithBodyWithResponse(ctx context.Context, groupUUID string, serviceUserUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddServiceUserToGroupResponse, error) {
+	return w.client.AddServiceUserToGroupWithBodyWithResponse(ctx, w.workspaceUUID, groupUUID, serviceUserUUID, contentType, body, reqEditors...)
+}
+
+// AddServiceUserToGroupWithResponse calls ClientWithResponses.AddServiceUserToGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) AddServiceUserToGroupWithResponse(ctx context.Context, groupUUID string, serviceUserUUID string, body AddServiceUserToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddServiceUserToGroupResponse, error) {
+	return w.client.AddServiceUserToGroupWithResponse(ctx, w.workspaceUUID, groupUUID, serviceUserUUID, body, reqEditors...)
+}
+
+// ListGroupUsersWithResponse calls ClientWithResponses.ListGroupUsersWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListGroupUsersWithResponse(ctx context.Context, groupUUID string, reqEditors ...RequestEditorFn) (*ListGroupUsersResponse, error) {
+	return w.client.ListGroupUsersWithResponse(ctx, w.workspaceUUID, groupUUID, reqEditors...)
+}
+
+// RemoveUserFromGroupWithResponse calls ClientWithResponses.RemoveUserFromGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) RemoveUserFromGroupWithResponse(ctx context.Context, groupUUID string, userUUID string, reqEditors ...RequestEditorFn) (*RemoveUserFromGroupResponse, error) {
+	return w.client.RemoveUserFromGroupWithResponse(ctx, w.workspaceUUID, groupUUID, userUUID, reqEditors...)
+}
+
+// AddUserToGroupWithBodyWithResponse calls ClientWithResponses.AddUserToGroupWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) AddUserToGroupWithBodyWithResponse(ctx context.Context, groupUUID string, userUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUserToGroupResponse, error) {
+	return w.client.AddUserToGroupWithBodyWithResponse(ctx, w.workspaceUUID, groupUUID, userUUID, contentType, body, reqEditors...)
+}
+
+// AddUserToGroupWithResponse calls ClientWithResponses.AddUserToGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) AddUserToGroupWithResponse(ctx context.Context, groupUUID string, userUUID string, body AddUserToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUserToGroupResponse, error) {
+	return w.client.AddUserToGroupWithResponse(ctx, w.workspaceUUID, groupUUID, userUUID, body, reqEditors...)
+}
+
+// InviteUsersToWorkspaceWithBodyWithResponse calls ClientWithResponses.InviteUsersToWorkspaceWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) InviteUsersToWorkspaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InviteUsersToWorkspaceResponse, error) {
+	return w.client.InviteUsersToWorkspaceWithBodyWithResponse(ctx, w.workspaceUUID, contentType, body, reqEditors...)
+}
+
+// InviteUsersToWorkspaceWithResponse calls ClientWithResponses.InviteUsersToWorkspaceWithResponse in the bound workspace.
+func (w *WorkspaceClient) InviteUsersToWorkspaceWithResponse(ctx context.Context, body InviteUsersToWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*InviteUsersToWorkspaceResponse, error) {
+	return w.client.InviteUsersToWorkspaceWithResponse(ctx, w.workspaceUUID, body, reqEditors...)
+}
+
+// ListServiceUserKiseKeysWithResponse calls ClientWithResponses.ListServiceUserKiseKeysWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListServiceUserKiseKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListServiceUserKiseKeysResponse, error) {
+	return w.client.ListServiceUserKiseKeysWithResponse(ctx, w.workspaceUUID, reqEditors...)
+}
+
+// ListRolesWithResponse calls ClientWithResponses.ListRolesWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListRolesWithResponse(ctx context.Context, params *ListRolesParams, reqEditors ...RequestEditorFn) (*ListRolesResponse, error) {
+	return w.client.ListRolesWithResponse(ctx, w.workspaceUUID, params, reqEditors...)
+}
+
+// CreateRoleWithBodyWithResponse calls ClientWithResponses.CreateRoleWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
+	return w.client.CreateRoleWithBodyWithResponse(ctx, w.workspaceUUID, contentType, body, reqEditors...)
+}
+
+// CreateRoleWithResponse calls ClientWithResponses.CreateRoleWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateRoleWithResponse(ctx context.Context, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
+	return w.client.CreateRoleWithResponse(ctx, w.workspaceUUID, body, reqEditors...)
+}
+
+// DeleteRoleWithResponse calls ClientWithResponses.DeleteRoleWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteRoleWithResponse(ctx context.Context, roleUUID string, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error) {
+	return w.client.DeleteRoleWithResponse(ctx, w.workspaceUUID, roleUUID, reqEditors...)
+}
+
+// GetRoleWithResponse calls ClientWithResponses.GetRoleWithResponse in the bound workspace.
+func (w *WorkspaceClient) GetRoleWithResponse(ctx context.Context, roleUUID string, reqEditors ...RequestEditorFn) (*GetRoleResponse, error) {
+	return w.client.GetRoleWithResponse(ctx, w.workspaceUUID, roleUUID, reqEditors...)
+}
+
+// BulkAddRulesToRoleWithBodyWithResponse calls ClientWithResponses.BulkAddRulesToRoleWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddRulesToRoleWithBodyWithResponse(ctx context.Context, roleUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkAddRulesToRoleResponse, error) {
+	return w.client.BulkAddRulesToRoleWithBodyWithResponse(ctx, w.workspaceUUID, roleUUID, contentType, body, reqEditors...)
+}
+
+// BulkAddRulesToRoleWithResponse calls ClientWithResponses.BulkAddRulesToRoleWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddRulesToRoleWithResponse(ctx context.Context, roleUUID string, body BulkAddRulesToRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkAddRulesToRoleResponse, error) {
+	return w.client.BulkAddRulesToRoleWithResponse(ctx, w.workspaceUUID, roleUUID, body, reqEditors...)
+}
+
+// BulkAddServiceUsersToRoleWithBodyWithResponse calls ClientWithResponses.BulkAddServiceUsersToRoleWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddServiceUsersToRoleWithBodyWithResponse(ctx context.Context, roleUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkAddServiceUsersToRoleResponse, error) {
+	return w.client.BulkAddServiceUsersToRoleWithBodyWithResponse(ctx, w.workspaceUUID, roleUUID, contentType, body, reqEditors...)
+}
+
+// BulkAddServiceUsersToRoleWithResponse calls ClientWithResponses.BulkAddServiceUsersToRoleWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddServiceUsersToRoleWithResponse(ctx context.Context, roleUUID string, body BulkAddServiceUsersToRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkAddServiceUsersToRoleResponse, error) {
+	return w.client.BulkAddServiceUsersToRoleWithResponse(ctx, w.workspaceUUID, roleUUID, body, reqEditors...)
+}
+
+// BulkAddUsersToRoleWithBodyWithResponse calls ClientWithResponses.BulkAddUsersToRoleWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddUsersToRoleWithBodyWithResponse(ctx context.Context, roleUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkAddUsersToRoleResponse, error) {
+	return w.client.BulkAddUsersToRoleWithBodyWithResponse(ctx, w.workspaceUUID, roleUUID, contentType, body, reqEditors...)
+}
+
+// BulkAddUsersToRoleWithResponse calls ClientWithResponses.BulkAddUsersToRoleWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkAddUsersToRoleWithResponse(ctx context.Context, roleUUID string, body BulkAddUsersToRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkAddUsersToRoleResponse, error) {
+	return w.client.BulkAddUsersToRoleWithResponse(ctx, w.workspaceUUID, roleUUID, body, reqEditors...)
+}
+
+// RemoveRoleFromGroupWithResponse calls ClientWithResponses.RemoveRoleFromGroupWithResponse in the bound workspace.
+func (w *WorkspaceClient) RemoveRoleFromGroupWithResponse(ctx context.Context, roleUUID string, groupUUID string, reqEditors ...RequestEditorFn) (*RemoveRoleFromGroupResponse, error) {
+	return w.client.RemoveRoleFromGroupWithResponse(ctx, w.workspaceUUID, roleUUID, groupUUID, reqEditors...)
+}
+
+// ListRoleRulesWithResponse calls ClientWithResponses.ListRoleRulesWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListRoleRulesWithResponse(ctx context.Context, roleUUID string, reqEditors ...RequestEditorFn) (*ListRoleRulesResponse, error) {
+	return w.client.ListRoleRulesWithResponse(ctx, w.workspaceUUID, roleUUID, reqEditors...)
+}
+
+// RemoveRuleFromRoleWithResponse calls ClientWithResponses.RemoveRuleFromRoleWithResponse in the bound workspace.
+func (w *WorkspaceClient) RemoveRuleFromRoleWithResponse(ctx context.Context, roleUUID string, ruleUUID string, reqEditors ...RequestEditorFn) (*RemoveRuleFromRoleResponse, error) {
+	return w.client.RemoveRuleFromRoleWithResponse(ctx, w.workspaceUUID, roleUUID, ruleUUID, reqEditors...)
+}
+
+// AddRuleToRoleWithBodyWithResponse calls ClientWithResponses.AddRuleToRoleWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) AddRuleToRoleWithBodyWithResponse(ctx context.Context, roleUUID string, ruleUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddRuleToRoleResponse, error) {
+	return w.client.AddRuleToRoleWithBodyWithResponse(ctx, w.workspaceUUID, roleUUID, ruleUUID, contentType, body, reqEditors...)
+}
+
+// AddRuleToRoleWithResponse calls ClientWithResponses.AddRuleToRoleWithResponse in the bound workspace.
+func (w *WorkspaceClient) AddRuleToRoleWithResponse(ctx context.Context, roleUUID string, ruleUUID string, body AddRuleToRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*AddRuleToRoleResponse, error) {
+	return w.client.AddRuleToRoleWithResponse(ctx, w.workspaceUUID, roleUUID, ruleUUID, body, reqEditors...)
+}
+
+// ListRolesServiceUsersWithResponse calls ClientWithResponses.ListRolesServiceUsersWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListRolesServiceUsersWithResponse(ctx context.Context, roleUUID string, reqEditors ...RequestEditorFn) (*ListRolesServiceUsersResponse, error) {
+	return w.client.ListRolesServiceUsersWithResponse(ctx, w.workspaceUUID, roleUUID, reqEditors...)
+}
+
+// RemoveRoleFromServiceUserWithResponse calls ClientWithResponses.RemoveRoleFromServiceUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) RemoveRoleFromServiceUserWithResponse(ctx context.Context, roleUUID string, serviceUserUUID string, reqEditors ...RequestEditorFn) (*RemoveRoleFromServiceUserResponse, error) {
+	return w.client.RemoveRoleFromServiceUserWithResponse(ctx, w.workspaceUUID, roleUUID, serviceUserUUID, reqEditors...)
+}
+
+// AssignRoleToServiceUserWithBodyWithResponse calls ClientWithResponses.AssignRoleToServiceUserWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) AssignRoleToServiceUserWithBodyWithResponse(ctx context.Context, roleUUID string, serviceUserUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignRoleToServiceUserResponse, error) {
+	return w.client.AssignRoleToServiceUserWithBodyWithResponse(ctx, w.workspaceUUID, roleUUID, serviceUserUUID, contentType, body, reqEditors...)
+}
+
+// AssignRoleToServiceUserWithResponse calls ClientWithResponses.AssignRoleToServiceUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) AssignRoleToServiceUserWithResponse(ctx context.Context, roleUUID string, serviceUserUUID string, body AssignRoleToServiceUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignRoleToServiceUserResponse, error) {
+	return w.client.AssignRoleToServiceUserWithResponse(ctx, w.workspaceUUID, roleUUID, serviceUserUUID, body, reqEditors...)
+}
+
+// ListRoleUsersWithResponse calls ClientWithResponses.ListRoleUsersWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListRoleUsersWithResponse(ctx context.Context, roleUUID string, reqEditors ...RequestEditorFn) (*ListRoleUsersResponse, error) {
+	return w.client.ListRoleUsersWithResponse(ctx, w.workspaceUUID, roleUUID, reqEditors...)
+}
+
+// RemoveRoleFromUserWithResponse calls ClientWithResponses.RemoveRoleFromUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) RemoveRoleFromUserWithResponse(ctx context.Context, roleUUID string, userUUID string, reqEditors ...RequestEditorFn) (*RemoveRoleFromUserResponse, error) {
+	return w.client.RemoveRoleFromUserWithResponse(ctx, w.workspaceUUID, roleUUID, userUUID, reqEditors...)
+}
+
+// ListRulesWithResponse calls ClientWithResponses.ListRulesWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRulesResponse, error) {
+	return w.client.ListRulesWithResponse(ctx, w.workspaceUUID, reqEditors...)
+}
+
+// CreateRuleWithBodyWithResponse calls ClientWithResponses.CreateRuleWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRuleResponse, error) {
+	return w.client.CreateRuleWithBodyWithResponse(ctx, w.workspaceUUID, contentType, body, reqEditors...)
+}
+
+// CreateRuleWithResponse calls ClientWithResponses.CreateRuleWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateRuleWithResponse(ctx context.Context, body CreateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRuleResponse, error) {
+	return w.client.CreateRuleWithResponse(ctx, w.workspaceUUID, body, reqEditors...)
+}
+
+// DeleteRuleWithResponse calls ClientWithResponses.DeleteRuleWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteRuleWithResponse(ctx context.Context, ruleUUID string, reqEditors ...RequestEditorFn) (*DeleteRuleResponse, error) {
+	return w.client.DeleteRuleWithResponse(ctx, w.workspaceUUID, ruleUUID, reqEditors...)
+}
+
+// GetRuleWithResponse calls ClientWithResponses.GetRuleWithResponse in the bound workspace.
+func (w *WorkspaceClient) GetRuleWithResponse(ctx context.Context, ruleUUID string, reqEditors ...RequestEditorFn) (*GetRuleResponse, error) {
+	return w.client.GetRuleWithResponse(ctx, w.workspaceUUID, ruleUUID, reqEditors...)
+}
+
+// UpdateRuleWithBodyWithResponse calls ClientWithResponses.UpdateRuleWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) UpdateRuleWithBodyWithResponse(ctx context.Context, ruleUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRuleResponse, error) {
+	return w.client.UpdateRuleWithBodyWithResponse(ctx, w.workspaceUUID, ruleUUID, contentType, body, reqEditors...)
+}
+
+// UpdateRuleWithResponse calls ClientWithResponses.UpdateRuleWithResponse in the bound workspace.
+func (w *WorkspaceClient) UpdateRuleWithResponse(ctx context.Context, ruleUUID string, body UpdateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRuleResponse, error) {
+	return w.client.UpdateRuleWithResponse(ctx, w.workspaceUUID, ruleUUID, body, reqEditors...)
+}
+
+// ListRuleRolesWithResponse calls ClientWithResponses.ListRuleRolesWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListRuleRolesWithResponse(ctx context.Context, ruleUUID string, reqEditors ...RequestEditorFn) (*ListRuleRolesResponse, error) {
+	return w.client.ListRuleRolesWithResponse(ctx, w.workspaceUUID, ruleUUID, reqEditors...)
+}
+
+// ListServiceUsersWithResponse calls ClientWithResponses.ListServiceUsersWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListServiceUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListServiceUsersResponse, error) {
+	return w.client.ListServiceUsersWithResponse(ctx, w.workspaceUUID, reqEditors...)
+}
+
+// CreateServiceUserWithBodyWithResponse calls ClientWithResponses.CreateServiceUserWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateServiceUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceUserResponse, error) {
+	return w.client.CreateServiceUserWithBodyWithResponse(ctx, w.workspaceUUID, contentType, body, reqEditors...)
+}
+
+// CreateServiceUserWithResponse calls ClientWithResponses.CreateServiceUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateServiceUserWithResponse(ctx context.Context, body CreateServiceUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceUserResponse, error) {
+	return w.client.CreateServiceUserWithResponse(ctx, w.workspaceUUID, body, reqEditors...)
+}
+
+// DeleteServiceUserWithResponse calls ClientWithResponses.DeleteServiceUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteServiceUserWithResponse(ctx context.Context, serviceUserUUID string, reqEditors ...RequestEditorFn) (*DeleteServiceUserResponse, error) {
+	return w.client.DeleteServiceUserWithResponse(ctx, w.workspaceUUID, serviceUserUUID, reqEditors...)
+}
+
+// UpdateServiceUserWithBodyWithResponse calls ClientWithResponses.UpdateServiceUserWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) UpdateServiceUserWithBodyWithResponse(ctx context.Context, serviceUserUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateServiceUserResponse, error) {
+	return w.client.UpdateServiceUserWithBodyWithResponse(ctx, w.workspaceUUID, serviceUserUUID, contentType, body, reqEditors...)
+}
+
+// UpdateServiceUserWithResponse calls ClientWithResponses.UpdateServiceUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) UpdateServiceUserWithResponse(ctx context.Context, serviceUserUUID string, body UpdateServiceUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateServiceUserResponse, error) {
+	return w.client.UpdateServiceUserWithResponse(ctx, w.workspaceUUID, serviceUserUUID, body, reqEditors...)
+}
+
+// CreateServiceUserKiseKeyWithBodyWithResponse calls ClientWithResponses.CreateServiceUserKiseKeyWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateServiceUserKiseKeyWithBodyWithResponse(ctx context.Context, serviceUserUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceUserKiseKeyResponse, error) {
+	return w.client.CreateServiceUserKiseKeyWithBodyWithResponse(ctx, w.workspaceUUID, serviceUserUUID, contentType, body, reqEditors...)
+}
+
+// CreateServiceUserKiseKeyWithResponse calls ClientWithResponses.CreateServiceUserKiseKeyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateServiceUserKiseKeyWithResponse(ctx context.Context, serviceUserUUID string, body CreateServiceUserKiseKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceUserKiseKeyResponse, error) {
+	return w.client.CreateServiceUserKiseKeyWithResponse(ctx, w.workspaceUUID, serviceUserUUID, body, reqEditors...)
+}
+
+// DeleteServiceUserKiseKeyWithResponse calls ClientWithResponses.DeleteServiceUserKiseKeyWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteServiceUserKiseKeyWithResponse(ctx context.Context, serviceUserUUID string, resourceUUID string, reqEditors ...RequestEditorFn) (*DeleteServiceUserKiseKeyResponse, error) {
+	return w.client.DeleteServiceUserKiseKeyWithResponse(ctx, w.workspaceUUID, serviceUserUUID, resourceUUID, reqEditors...)
+}
+
+// ListServiceUserPublicKeysWithResponse calls ClientWithResponses.ListServiceUserPublicKeysWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListServiceUserPublicKeysWithResponse(ctx context.Context, serviceUserUUID string, reqEditors ...RequestEditorFn) (*ListServiceUserPublicKeysResponse, error) {
+	return w.client.ListServiceUserPublicKeysWithResponse(ctx, w.workspaceUUID, serviceUserUUID, reqEditors...)
+}
+
+// CreateServiceUserPublicKeyWithBodyWithResponse calls ClientWithResponses.CreateServiceUserPublicKeyWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateServiceUserPublicKeyWithBodyWithResponse(ctx context.Context, serviceUserUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceUserPublicKeyResponse, error) {
+	return w.client.CreateServiceUserPublicKeyWithBodyWithResponse(ctx, w.workspaceUUID, serviceUserUUID, contentType, body, reqEditors...)
+}
+
+// CreateServiceUserPublicKeyWithResponse calls ClientWithResponses.CreateServiceUserPublicKeyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateServiceUserPublicKeyWithResponse(ctx context.Context, serviceUserUUID string, body CreateServiceUserPublicKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceUserPublicKeyResponse, error) {
+	return w.client.CreateServiceUserPublicKeyWithResponse(ctx, w.workspaceUUID, serviceUserUUID, body, reqEditors...)
+}
+
+// DeleteServiceUserPublicKeyWithResponse calls ClientWithResponses.DeleteServiceUserPublicKeyWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteServiceUserPublicKeyWithResponse(ctx context.Context, serviceUserUUID string, resourceUUID string, reqEditors ...RequestEditorFn) (*DeleteServiceUserPublicKeyResponse, error) {
+	return w.client.DeleteServiceUserPublicKeyWithResponse(ctx, w.workspaceUUID, serviceUserUUID, resourceUUID, reqEditors...)
+}
+
+// ListServiceUserTokensWithResponse calls ClientWithResponses.ListServiceUserTokensWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListServiceUserTokensWithResponse(ctx context.Context, serviceUserUUID string, reqEditors ...RequestEditorFn) (*ListServiceUserTokensResponse, error) {
+	return w.client.ListServiceUserTokensWithResponse(ctx, w.workspaceUUID, serviceUserUUID, reqEditors...)
+}
+
+// CreateServiceUserTokenWithBodyWithResponse calls ClientWithResponses.CreateServiceUserTokenWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateServiceUserTokenWithBodyWithResponse(ctx context.Context, serviceUserUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceUserTokenResponse, error) {
+	return w.client.CreateServiceUserTokenWithBodyWithResponse(ctx, w.workspaceUUID, serviceUserUUID, contentType, body, reqEditors...)
+}
+
+// CreateServiceUserTokenWithResponse calls ClientWithResponses.CreateServiceUserTokenWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateServiceUserTokenWithResponse(ctx context.Context, serviceUserUUID string, body CreateServiceUserTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceUserTokenResponse, error) {
+	return w.client.CreateServiceUserTokenWithResponse(ctx, w.workspaceUUID, serviceUserUUID, body, reqEditors...)
+}
+
+// DeleteServiceUserTokenWithResponse calls ClientWithResponses.DeleteServiceUserTokenWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteServiceUserTokenWithResponse(ctx context.Context, serviceUserUUID string, resourceUUID string, reqEditors ...RequestEditorFn) (*DeleteServiceUserTokenResponse, error) {
+	return w.client.DeleteServiceUserTokenWithResponse(ctx, w.workspaceUUID, serviceUserUUID, resourceUUID, reqEditors...)
+}
+
+// ListServicesWithResponse calls ClientWithResponses.ListServicesWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListServicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListServicesResponse, error) {
+	return w.client.ListServicesWithResponse(ctx, w.workspaceUUID, reqEditors...)
+}
+
+// BulkRefreshThirdPartyTokensWithBodyWithResponse calls ClientWithResponses.BulkRefreshThirdPartyTokensWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkRefreshThirdPartyTokensWithBodyWithResponse(ctx context.Context, thirdPartyUUID string, serviceUserUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkRefreshThirdPartyTokensResponse, error) {
+	return w.client.BulkRefreshThirdPartyTokensWithBodyWithResponse(ctx, w.workspaceUUID, thirdPartyUUID, serviceUserUUID, contentType, body, reqEditors...)
+}
+
+// BulkRefreshThirdPartyTokensWithResponse calls ClientWithResponses.BulkRefreshThirdPartyTokensWithResponse in the bound workspace.
+func (w *WorkspaceClient) BulkRefreshThirdPartyTokensWithResponse(ctx context.Context, thirdPartyUUID string, serviceUserUUID string, body BulkRefreshThirdPartyTokensJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkRefreshThirdPartyTokensResponse, error) {
+	return w.client.BulkRefreshThirdPartyTokensWithResponse(ctx, w.workspaceUUID, thirdPartyUUID, serviceUserUUID, body, reqEditors...)
+}
+
+// ListWorkspaceUsersWithResponse calls ClientWithResponses.ListWorkspaceUsersWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListWorkspaceUsersWithResponse(ctx context.Context, params *ListWorkspaceUsersParams, reqEditors ...RequestEditorFn) (*ListWorkspaceUsersResponse, error) {
+	return w.client.ListWorkspaceUsersWithResponse(ctx, w.workspaceUUID, params, reqEditors...)
+}
+
+// RemoveUserFromWorkspaceWithResponse calls ClientWithResponses.RemoveUserFromWorkspaceWithResponse in the bound workspace.
+func (w *WorkspaceClient) RemoveUserFromWorkspaceWithResponse(ctx context.Context, userUUID string, reqEditors ...RequestEditorFn) (*RemoveUserFromWorkspaceResponse, error) {
+	return w.client.RemoveUserFromWorkspaceWithResponse(ctx, w.workspaceUUID, userUUID, reqEditors...)
+}
+
+// AllowUserWithBodyWithResponse calls ClientWithResponses.AllowUserWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) AllowUserWithBodyWithResponse(ctx context.Context, userUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AllowUserResponse, error) {
+	return w.client.AllowUserWithBodyWithResponse(ctx, w.workspaceUUID, userUUID, contentType, body, reqEditors...)
+}
+
+// AllowUserWithResponse calls ClientWithResponses.AllowUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) AllowUserWithResponse(ctx context.Context, userUUID string, body AllowUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AllowUserResponse, error) {
+	return w.client.AllowUserWithResponse(ctx, w.workspaceUUID, userUUID, body, reqEditors...)
+}
+
+// ListUserKiseKeysWithResponse calls ClientWithResponses.ListUserKiseKeysWithResponse in the bound workspace.
+func (w *WorkspaceClient) ListUserKiseKeysWithResponse(ctx context.Context, userUUID string, reqEditors ...RequestEditorFn) (*ListUserKiseKeysResponse, error) {
+	return w.client.ListUserKiseKeysWithResponse(ctx, w.workspaceUUID, userUUID, reqEditors...)
+}
+
+// CreateUserKiseKeyWithBodyWithResponse calls ClientWithResponses.CreateUserKiseKeyWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateUserKiseKeyWithBodyWithResponse(ctx context.Context, userUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserKiseKeyResponse, error) {
+	return w.client.CreateUserKiseKeyWithBodyWithResponse(ctx, w.workspaceUUID, userUUID, contentType, body, reqEditors...)
+}
+
+// CreateUserKiseKeyWithResponse calls ClientWithResponses.CreateUserKiseKeyWithResponse in the bound workspace.
+func (w *WorkspaceClient) CreateUserKiseKeyWithResponse(ctx context.Context, userUUID string, body CreateUserKiseKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserKiseKeyResponse, error) {
+	return w.client.CreateUserKiseKeyWithResponse(ctx, w.workspaceUUID, userUUID, body, reqEditors...)
+}
+
+// DeleteUserKiseKeyWithResponse calls ClientWithResponses.DeleteUserKiseKeyWithResponse in the bound workspace.
+func (w *WorkspaceClient) DeleteUserKiseKeyWithResponse(ctx context.Context, userUUID string, resourceUUID string, reqEditors ...RequestEditorFn) (*DeleteUserKiseKeyResponse, error) {
+	return w.client.DeleteUserKiseKeyWithResponse(ctx, w.workspaceUUID, userUUID, resourceUUID, reqEditors...)
+}
+
+// SuspendUserWithBodyWithResponse calls ClientWithResponses.SuspendUserWithBodyWithResponse in the bound workspace.
+func (w *WorkspaceClient) SuspendUserWithBodyWithResponse(ctx context.Context, userUUID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SuspendUserResponse, error) {
+	return w.client.SuspendUserWithBodyWithResponse(ctx, w.workspaceUUID, userUUID, contentType, body, reqEditors...)
+}
+
+// SuspendUserWithResponse calls ClientWithResponses.SuspendUserWithResponse in the bound workspace.
+func (w *WorkspaceClient) SuspendUserWithResponse(ctx context.Context, userUUID string, body SuspendUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SuspendUserResponse, error) {
+	return w.client.SuspendUserWithResponse(ctx, w.workspaceUUID, userUUID, body, reqEditors...)
+}
@@ -0,0 +1,97 @@
+package sotton
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// EnvAPIURL is the environment variable consulted when no endpoint has been
+// configured explicitly.
+const EnvAPIURL = "SOTOON_API_URL"
+
+// Region selects one of the public Sotoon API deployments.
+type Region string
+
+const (
+	RegionIR Region = "ir"
+	RegionIO Region = "io"
+)
+
+var regionEndpoints = map[Region]string{
+	RegionIR: "https://api.sotoon.ir",
+	RegionIO: "https://api.sotoon.io",
+}
+
+// EndpointResolver returns the server address a service handler should talk to.
+// service is the module name of the handler (e.g. "iam_v1").
+type EndpointResolver interface {
+	ResolveEndpoint(service string) (string, error)
+}
+
+// EndpointResolverFunc adapts a plain function to EndpointResolver.
+type EndpointResolverFunc func(service string) (string, error)
+
+func (f EndpointResolverFunc) ResolveEndpoint(service string) (string, error) {
+	return f(service)
+}
+
+// StaticEndpointResolver resolves every service to the same address.
+type StaticEndpointResolver string
+
+func (r StaticEndpointResolver) ResolveEndpoint(service string) (string, error) {
+	return string(r), nil
+}
+
+// NewRegionEndpointResolver resolves every service to the preset address of the given region.
+// Unknown regions fail at resolution time.
+func NewRegionEndpointResolver(region Region) EndpointResolver {
+	return EndpointResolverFunc(func(service string) (string, error) {
+		address, ok := regionEndpoints[region]
+		if !ok {
+			return "", fmt.Errorf("unknown region %q", region)
+		}
+		return address, nil
+	})
+}
+
+// DefaultEndpointResolver uses SOTOON_API_URL when it is set and the .ir region otherwise.
+type DefaultEndpointResolver struct{}
+
+func (DefaultEndpointResolver) ResolveEndpoint(service string) (string, error) {
+	if address := strings.TrimSpace(os.Getenv(EnvAPIURL)); address != "" {
+		return address, nil
+	}
+	return regionEndpoints[RegionIR], nil
+}
+
+// resolveEndpoint applies per-service overrides first, then falls back to the configured resolver.
+func (o *sdkOptions) resolveEndpoint(service string) (string, error) {
+	address, ok := o.serviceEndpoints[service]
+	if !ok {
+		var err error
+		address, err = o.endpointResolver.ResolveEndpoint(service)
+		if err != nil {
+			return "", fmt.Errorf("resolve endpoint for %s: %w", service, err)
+		}
+	}
+	if err := validateEndpoint(address); err != nil {
+		return "", fmt.Errorf("invalid endpoint for %s: %w", service, err)
+	}
+	return address, nil
+}
+
+func validateEndpoint(address string) error {
+	if address == "" {
+		return fmt.Errorf("empty server address")
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("server address %q must be an absolute URL", address)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package sotton
+
+import (
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+// APIError is the error of a call answered with a 4xx or 5xx status, see interceptors.APIError.
+type APIError = interceptors.APIError
+
+// Sentinels matched by errors.Is against an *APIError, e.g.:
+//
+//	if errors.Is(err, sotton.ErrNotFound) { ... }
+var (
+	ErrUnauthorized = constants.ErrUnauthorized
+	ErrForbidden    = constants.ErrForbidden
+	ErrNotFound     = constants.ErrNotFound
+	ErrConflict     = constants.ErrConflict
+	ErrRateLimited  = constants.ErrRateLimited
+	ErrServerError  = constants.ErrServerError
+)
+
+// Response is a response of a generated client with a success payload of type T, such as
+// *iam_v1.ListGroupsResponse with T []iam_v1.IamGroup.
+type Response[T any] interface {
+	Err() error
+	Payload() T
+}
+
+// Unwrap returns the success payload of a WithResponse call, or its error: the error of
+// the call, or the *APIError of a 4xx or 5xx response.
+//
+//	groups, err := sotton.Unwrap[[]iam_v1.IamGroup](ws.ListGroupsWithResponse(ctx))
+func Unwrap[T any](resp Response[T], err error) (T, error) {
+	var zero T
+	if err != nil {
+		return zero, err
+	}
+	if err := resp.Err(); err != nil {
+		return zero, err
+	}
+	return resp.Payload(), nil
+}
@@ -0,0 +1,163 @@
+package interceptors
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+)
+
+// APIError is the error of a call answered with a 4xx or 5xx status. errors.Is matches it
+// against the sentinel of its status: constants.ErrUnauthorized, ErrForbidden, ErrNotFound,
+// ErrConflict, ErrRateLimited or ErrServerError.
+type APIError struct {
+	StatusCode int
+	// Code, Status, Reason and Detail come from the error body, when the server sent one.
+	Code   string
+	Status string
+	Reason string
+	Detail string
+	// Operation is the operation of the call, e.g. "iam_v1.GetUser"; empty when unknown.
+	Operation string
+	// RequestID is the X-Request-Id of the response, or the ID the SDK gave the call.
+	RequestID string
+	Header    http.Header
+	Body      []byte
+}
+
+// NewAPIError returns the error of response, whose body was read into body, or nil when
+// its status is below 400.
+func NewAPIError(response *http.Response, body []byte) *APIError {
+	if response == nil || response.StatusCode < 400 {
+		return nil
+	}
+
+	e := &APIError{
+		StatusCode: response.StatusCode,
+		RequestID:  response.Header.Get("X-Request-Id"),
+		Header:     response.Header.Clone(),
+		Body:       body,
+	}
+	if req := response.Request; req != nil {
+		if operation, ok := OperationFromContext(req.Context()); ok {
+			e.Operation = operation.String()
+		}
+		if e.RequestID == "" {
+			e.RequestID = RequestIDFromContext(req.Context())
+		}
+	}
+	e.parseBody(body)
+	return e
+}
+
+// ReadAPIError is NewAPIError for a response whose body has not been read yet. The body is
+// put back, so the response can still be decoded.
+func ReadAPIError(response *http.Response) (*APIError, error) {
+	if response == nil || response.StatusCode < 400 {
+		return nil, nil
+	}
+	var body []byte
+	if response.Body != nil && response.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(response.Body)
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewAPIError(response, body), nil
+}
+
+// errorBody covers the error bodies of the services, e.g. IamError:
+// {"code": 404, "message": {"detail": "..."}, "reason": "...", "status": "..."}.
+type errorBody struct {
+	Code    json.RawMessage `json:"code"`
+	Status  json.RawMessage `json:"status"`
+	Reason  json.RawMessage `json:"reason"`
+	Message json.RawMessage `json:"message"`
+	Detail  json.RawMessage `json:"detail"`
+	Details json.RawMessage `json:"details"`
+	Error   json.RawMessage `json:"error"`
+}
+
+// parseBody fills the fields found in body. Bodies that are not JSON are left out.
+func (e *APIError) parseBody(body []byte) {
+	var parsed errorBody
+	if json.Unmarshal(body, &parsed) != nil {
+		return
+	}
+	e.Code = jsonText(parsed.Code)
+	e.Status = jsonText(parsed.Status)
+	e.Reason = jsonText(parsed.Reason)
+
+	var message struct {
+		Detail json.RawMessage `json:"detail"`
+	}
+	if json.Unmarshal(parsed.Message, &message) == nil {
+		e.Detail = jsonText(message.Detail)
+	}
+	for _, raw := range []json.RawMessage{parsed.Detail, parsed.Details, parsed.Message, parsed.Error} {
+		if e.Detail != "" {
+			break
+		}
+		e.Detail = jsonText(raw)
+	}
+}
+
+// jsonText returns a JSON string or number as text, and "" for anything else.
+func jsonText(raw json.RawMessage) string {
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return text
+	}
+	var number json.Number
+	if json.Unmarshal(raw, &number) == nil {
+		return number.String()
+	}
+	return ""
+}
+
+// Error returns e.g. "iam_v1.GetUser: 404 Not Found: user not found".
+func (e *APIError) Error() string {
+	var b strings.Builder
+	if e.Operation != "" {
+		b.WriteString(e.Operation + ": ")
+	}
+	fmt.Fprintf(&b, "%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	switch {
+	case e.Detail != "":
+		b.WriteString(": " + e.Detail)
+	case e.Reason != "":
+		b.WriteString(": " + e.Reason)
+	}
+	return b.String()
+}
+
+// Is matches the sentinel of the status code, e.g. errors.Is(err, constants.ErrNotFound).
+func (e *APIError) Is(target error) bool {
+	sentinel := statusSentinel(e.StatusCode)
+	return sentinel != nil && target == sentinel
+}
+
+func statusSentinel(statusCode int) error {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return constants.ErrUnauthorized
+	case statusCode == http.StatusForbidden:
+		return constants.ErrForbidden
+	case statusCode == http.StatusNotFound:
+		return constants.ErrNotFound
+	case statusCode == http.StatusConflict:
+		return constants.ErrConflict
+	case statusCode == http.StatusTooManyRequests:
+		return constants.ErrRateLimited
+	case statusCode >= 500:
+		return constants.ErrServerError
+	}
+	return nil
+}
@@ -1,17 +1,30 @@
 package interceptors
 
 type Authenticator struct {
-	secretKey string
+	credentials CredentialsProvider
 }
 
 func NewAuthenticator(secretKey string) *Authenticator {
+	return NewAuthenticatorWithCredentials(NewStaticCredentials(secretKey))
+}
+
+// NewAuthenticatorWithCredentials asks credentials for the secret key on every request.
+func NewAuthenticatorWithCredentials(credentials CredentialsProvider) *Authenticator {
 	return &Authenticator{
-		secretKey: secretKey,
+		credentials: credentials,
 	}
 }
 
+func (a *Authenticator) Name() string {
+	return AuthenticatorName
+}
+
 func (a *Authenticator) BeforeRequest(data InterceptorData) (InterceptorData, error) {
-	data.Request.Header.Set("Authorization", "Bearer "+a.secretKey)
+	secretKey, err := a.credentials.SecretKey(data.Ctx)
+	if err != nil {
+		return data, err
+	}
+	data.Request.Header.Set("Authorization", "Bearer "+secretKey)
 	return data, nil
 }
 
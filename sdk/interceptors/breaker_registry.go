@@ -0,0 +1,268 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+)
+
+// BreakerKeyFunc tells which breaker of a CircuitBreakerRegistry guards a request.
+type BreakerKeyFunc func(req *http.Request) string
+
+// BreakerKeyByOperation gives every operation its own breaker, e.g. "iam_v1.CreateGroup".
+// Requests of unknown operations share a breaker per host.
+func BreakerKeyByOperation(req *http.Request) string {
+	if operation, ok := OperationFromContext(req.Context()); ok {
+		return operation.String()
+	}
+	return req.URL.Host
+}
+
+// BreakerKeyByPathTemplate gives every method and path template its own breaker,
+// e.g. "iam_v1 POST /iam/v1/api/v1/workspace/{workspaceUUID}/group/".
+func BreakerKeyByPathTemplate(req *http.Request) string {
+	if operation, ok := OperationFromContext(req.Context()); ok {
+		return operation.Service + " " + operation.Method + " " + operation.PathTemplate
+	}
+	return req.URL.Host
+}
+
+// BreakerKeyByService gives every service its own breaker, e.g. "iam_v1".
+func BreakerKeyByService(req *http.Request) string {
+	if operation, ok := OperationFromContext(req.Context()); ok {
+		return operation.Service
+	}
+	return req.URL.Host
+}
+
+// BreakerOutcome is how a call counts for its breaker.
+type BreakerOutcome int
+
+const (
+	BreakerSuccess BreakerOutcome = iota
+	BreakerFailure
+)
+
+// BreakerClassifier decides how the outcome of a call counts for its breaker.
+type BreakerClassifier func(response *http.Response, err error) BreakerOutcome
+
+// DefaultBreakerClassifier counts network errors, 429 and 5xx responses as failures.
+// Cancellations by the caller and other statuses, including 4xx, count as successes:
+// they say nothing about the health of the endpoint.
+func DefaultBreakerClassifier(response *http.Response, err error) BreakerOutcome {
+	if errors.Is(err, context.Canceled) {
+		return BreakerSuccess
+	}
+	if response == nil {
+		if err != nil {
+			return BreakerFailure
+		}
+		return BreakerSuccess
+	}
+	if response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500 {
+		return BreakerFailure
+	}
+	return BreakerSuccess
+}
+
+// DefaultBreakerSettings opens a breaker after 5 consecutive failures for 30 seconds.
+func DefaultBreakerSettings(key string) gobreaker.Settings {
+	return gobreaker.Settings{
+		Name:    key,
+		Timeout: 30 * time.Second,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= 5
+		},
+	}
+}
+
+// defaultBreakerTimeout is the open period gobreaker uses when Settings.Timeout is zero.
+const defaultBreakerTimeout = 60 * time.Second
+
+type CircuitBreakerRegistryOptions struct {
+	// Settings builds the settings of the breaker for a key. Default DefaultBreakerSettings.
+	// IsSuccessful is not used: outcomes come from Classify.
+	Settings func(key string) gobreaker.Settings
+	// Key picks the breaker of a request. Default BreakerKeyByOperation.
+	Key BreakerKeyFunc
+	// Classify decides whether a call succeeded. Default DefaultBreakerClassifier.
+	Classify BreakerClassifier
+	// OnStateChange is called whenever a breaker changes state, after the OnStateChange
+	// of its settings. It runs while the breaker is locked, so it must not query the registry.
+	OnStateChange func(key string, from, to gobreaker.State)
+}
+
+// CircuitBreakerRegistry holds one circuit breaker per key, created on first use, so a
+// failing endpoint only blocks its own calls. It is safe for concurrent use and can be
+// shared by several handlers.
+type CircuitBreakerRegistry struct {
+	opts CircuitBreakerRegistryOptions
+
+	mu       sync.Mutex
+	breakers map[string]*registeredBreaker
+}
+
+type registeredBreaker struct {
+	cb      *gobreaker.TwoStepCircuitBreaker
+	timeout time.Duration
+
+	mu       sync.Mutex
+	openedAt time.Time
+}
+
+func NewCircuitBreakerRegistry(opts CircuitBreakerRegistryOptions) *CircuitBreakerRegistry {
+	if opts.Settings == nil {
+		opts.Settings = DefaultBreakerSettings
+	}
+	if opts.Key == nil {
+		opts.Key = BreakerKeyByOperation
+	}
+	if opts.Classify == nil {
+		opts.Classify = DefaultBreakerClassifier
+	}
+	return &CircuitBreakerRegistry{
+		opts:     opts,
+		breakers: map[string]*registeredBreaker{},
+	}
+}
+
+// States returns the current state of every breaker created so far.
+func (r *CircuitBreakerRegistry) States() map[string]gobreaker.State {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	states := make(map[string]gobreaker.State, len(r.breakers))
+	for key, breaker := range r.breakers {
+		states[key] = breaker.cb.State()
+	}
+	return states
+}
+
+// State returns the state of the breaker for key; false if no call used it yet.
+func (r *CircuitBreakerRegistry) State(key string) (gobreaker.State, bool) {
+	r.mu.Lock()
+	breaker, ok := r.breakers[key]
+	r.mu.Unlock()
+	if !ok {
+		return gobreaker.StateClosed, false
+	}
+	return breaker.cb.State(), true
+}
+
+// Key returns the key of the breaker guarding req.
+func (r *CircuitBreakerRegistry) Key(req *http.Request) string {
+	return r.opts.Key(req)
+}
+
+// Keys returns the keys of the breakers created so far, sorted.
+func (r *CircuitBreakerRegistry) Keys() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make([]string, 0, len(r.breakers))
+	for key := range r.breakers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (r *CircuitBreakerRegistry) breaker(key string) *registeredBreaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if breaker, ok := r.breakers[key]; ok {
+		return breaker
+	}
+
+	settings := r.opts.Settings(key)
+	breaker := &registeredBreaker{timeout: settings.Timeout}
+	if breaker.timeout <= 0 {
+		breaker.timeout = defaultBreakerTimeout
+	}
+	onStateChange := settings.OnStateChange
+	settings.OnStateChange = func(name string, from, to gobreaker.State) {
+		if to == gobreaker.StateOpen {
+			breaker.mu.Lock()
+			breaker.openedAt = time.Now()
+			breaker.mu.Unlock()
+		}
+		if onStateChange != nil {
+			onStateChange(name, from, to)
+		}
+		if r.opts.OnStateChange != nil {
+			r.opts.OnStateChange(key, from, to)
+		}
+	}
+	breaker.cb = gobreaker.NewTwoStepCircuitBreaker(settings)
+	r.breakers[key] = breaker
+	return breaker
+}
+
+// allow asks the breaker of key to let a call through and returns the callback recording
+// its outcome.
+func (r *CircuitBreakerRegistry) allow(key string) (func(success bool), error) {
+	breaker := r.breaker(key)
+	done, err := breaker.cb.Allow()
+	if err == nil {
+		return done, nil
+	}
+
+	openErr := &BreakerOpenError{Key: key, State: breaker.cb.State()}
+	if errors.Is(err, gobreaker.ErrOpenState) {
+		breaker.mu.Lock()
+		openErr.RemainingCooldown = nonNegative(breaker.timeout - time.Since(breaker.openedAt))
+		breaker.mu.Unlock()
+	}
+	return nil, openErr
+}
+
+// BreakerOpenError is returned for calls rejected by an open breaker, or by a half-open
+// breaker already probing with as many calls as its settings allow.
+type BreakerOpenError struct {
+	Key   string
+	State gobreaker.State
+	// RemainingCooldown is the time until an open breaker lets probe calls through again.
+	RemainingCooldown time.Duration
+}
+
+func (e *BreakerOpenError) Error() string {
+	if e.State == gobreaker.StateHalfOpen {
+		return fmt.Sprintf("circuit breaker %s is half-open and busy probing", e.Key)
+	}
+	return fmt.Sprintf("circuit breaker %s is open, retry in %s", e.Key, e.RemainingCooldown.Round(time.Millisecond))
+}
+
+// Unwrap lets errors.Is(err, constants.ErrCircuitBreakerOpen) match.
+func (e *BreakerOpenError) Unwrap() error {
+	return constants.ErrCircuitBreakerOpen
+}
+
+// NewEndpointCircuitBreakerMiddleware guards every send with the breaker its registry picks
+// for it, so one failing endpoint does not block the whole API. A rejected send fails with a
+// *BreakerOpenError, which the AfterResponse of every interceptor sees.
+//
+// The outcome is recorded as soon as the send returns, so a call let through always reports
+// back to its breaker, whatever the interceptor chain does. Add it after NewRetryMiddleware
+// to guard each attempt.
+func NewEndpointCircuitBreakerMiddleware(registry *CircuitBreakerRegistry) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (resp *http.Response, err error) {
+			done, err := registry.allow(registry.opts.Key(req))
+			if err != nil {
+				return nil, err
+			}
+			defer func() {
+				done(registry.opts.Classify(resp, err) == BreakerSuccess)
+			}()
+			return next.RoundTrip(req)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package interceptors
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+)
+
+// Names of the built-in interceptors, as reported by InterceptorTransport.List.
+const (
+	UserAgentName      = "user-agent"
+	IdempotencyKeyName = "idempotency-key"
+	AuthenticatorName  = "authenticator"
+	LoggerName         = "logger"
+	SlogLoggerName     = "slog-logger"
+	RetryName          = "retry"
+	TreatAsErrorName   = "treat-as-error"
+	CircuitBreakerName = "circuit-breaker"
+	RateLimiterName    = "rate-limiter"
+	MetricsName        = "metrics"
+	ResponseCacheName  = "response-cache"
+	// TracingName names the OpenTelemetry tracer of the tracing package.
+	TracingName = "tracing"
+)
+
+// NamedInterceptor is an Interceptor that can be addressed by name when editing a chain.
+type NamedInterceptor interface {
+	Interceptor
+	Name() string
+}
+
+type namedInterceptor struct {
+	Interceptor
+	name string
+}
+
+// Named gives interceptor a name, so it can be found by InsertBefore, InsertAfter, Remove and Replace.
+func Named(name string, interceptor Interceptor) NamedInterceptor {
+	return &namedInterceptor{Interceptor: interceptor, name: name}
+}
+
+func (n *namedInterceptor) Name() string {
+	return n.name
+}
+
+func (n *namedInterceptor) Close() error {
+	if closer, ok := n.Interceptor.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// InterceptorName returns the name of a NamedInterceptor, or its Go type otherwise.
+func InterceptorName(interceptor Interceptor) string {
+	if named, ok := interceptor.(NamedInterceptor); ok {
+		return named.Name()
+	}
+	return fmt.Sprintf("%T", interceptor)
+}
+
+// ChainEdit is a deferred change to an interceptor chain. Handlers and the SDK apply
+// them in order when they build their transports.
+type ChainEdit func(*InterceptorTransport) error
+
+func AppendInterceptors(interceptors ...Interceptor) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		it.AddInterceptors(interceptors...)
+		return nil
+	}
+}
+
+func PrependInterceptors(interceptors ...Interceptor) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		it.Prepend(interceptors...)
+		return nil
+	}
+}
+
+func InsertInterceptorsBefore(name string, interceptors ...Interceptor) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		return it.InsertBefore(name, interceptors...)
+	}
+}
+
+func InsertInterceptorsAfter(name string, interceptors ...Interceptor) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		return it.InsertAfter(name, interceptors...)
+	}
+}
+
+func RemoveInterceptor(name string) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		return it.Remove(name)
+	}
+}
+
+func ReplaceInterceptor(name string, interceptor Interceptor) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		return it.Replace(name, interceptor)
+	}
+}
+
+func (it *InterceptorTransport) AddInterceptors(interceptors ...Interceptor) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.interceptors = append(it.cloneChain(), interceptors...)
+}
+
+// Prepend puts the interceptors in front of the chain, before the defaults.
+func (it *InterceptorTransport) Prepend(interceptors ...Interceptor) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.interceptors = append(append([]Interceptor{}, interceptors...), it.interceptors...)
+}
+
+// InsertBefore puts the interceptors right before the first interceptor called name.
+func (it *InterceptorTransport) InsertBefore(name string, interceptors ...Interceptor) error {
+	return it.insert(name, 0, interceptors)
+}
+
+// InsertAfter puts the interceptors right after the first interceptor called name.
+func (it *InterceptorTransport) InsertAfter(name string, interceptors ...Interceptor) error {
+	return it.insert(name, 1, interceptors)
+}
+
+// Remove drops the first interceptor called name.
+func (it *InterceptorTransport) Remove(name string) error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	index, err := it.indexOf(name)
+	if err != nil {
+		return err
+	}
+	chain := it.cloneChain()
+	it.interceptors = append(chain[:index], chain[index+1:]...)
+	return nil
+}
+
+// Replace swaps the first interceptor called name, e.g. to use a custom auth scheme
+// instead of the default Authenticator.
+func (it *InterceptorTransport) Replace(name string, interceptor Interceptor) error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	index, err := it.indexOf(name)
+	if err != nil {
+		return err
+	}
+	chain := it.cloneChain()
+	chain[index] = interceptor
+	it.interceptors = chain
+	return nil
+}
+
+// List returns the names of the interceptors in chain order.
+func (it *InterceptorTransport) List() []string {
+	chain := it.chain()
+	names := make([]string, len(chain))
+	for i, interceptor := range chain {
+		names[i] = InterceptorName(interceptor)
+	}
+	return names
+}
+
+func (it *InterceptorTransport) insert(name string, offset int, interceptors []Interceptor) error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	index, err := it.indexOf(name)
+	if err != nil {
+		return err
+	}
+	index += offset
+	chain := make([]Interceptor, 0, len(it.interceptors)+len(interceptors))
+	chain = append(chain, it.interceptors[:index]...)
+	chain = append(chain, interceptors...)
+	it.interceptors = append(chain, it.interceptors[index:]...)
+	return nil
+}
+
+// indexOf must be called with it.mu held.
+func (it *InterceptorTransport) indexOf(name string) (int, error) {
+	for i, interceptor := range it.interceptors {
+		if InterceptorName(interceptor) == name {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("%w: %s", constants.ErrInterceptorNotFound, name)
+}
+
+// cloneChain copies the chain so requests holding the previous slice are not affected.
+// It must be called with it.mu held.
+func (it *InterceptorTransport) cloneChain() []Interceptor {
+	return append([]Interceptor{}, it.interceptors...)
+}
+
+// chain returns the current interceptors. The slice is never modified in place.
+func (it *InterceptorTransport) chain() []Interceptor {
+	it.mu.RLock()
+	defer it.mu.RUnlock()
+	return it.interceptors
+}
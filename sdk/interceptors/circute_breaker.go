@@ -1,7 +1,9 @@
 package interceptors
 
 import (
-	"fmt"
+	"errors"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/sony/gobreaker"
@@ -22,12 +24,24 @@ var CircuteBreakerForJust429 = gobreaker.NewCircuitBreaker(gobreaker.Settings{
 		return counts.ConsecutiveFailures > 0 // instant circute openinig
 	},
 	IsSuccessful: func(err error) bool {
-		return err.Error() != "429"
+		var statusErr *BreakerStatusError
+		return !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusTooManyRequests
 	},
 })
 
+// BreakerStatusError carries the status code of a response to the IsSuccessful function of
+// the breaker. Its message is the bare code (e.g. "429").
+type BreakerStatusError struct {
+	StatusCode int
+}
+
+func (e *BreakerStatusError) Error() string {
+	return strconv.Itoa(e.StatusCode)
+}
+
 // NewCircuitBreakerInterceptor creates a new circuit breaker interceptor
-// faced status codes (including 2xx) are passed as simple string to ReadyToTrip function.
+// faced status codes (including 2xx) are passed as *BreakerStatusError to IsSuccessful.
+// To give each endpoint its own breaker use NewEndpointCircuitBreakerMiddleware.
 func NewCircuitBreakerInterceptor(cb *gobreaker.CircuitBreaker, abortOnFailure bool) *CircuitBreakerInterceptor {
 	if cb == nil {
 		panic("cb should not be nil")
@@ -39,6 +53,10 @@ func NewCircuitBreakerInterceptor(cb *gobreaker.CircuitBreaker, abortOnFailure b
 	}
 }
 
+func (c *CircuitBreakerInterceptor) Name() string {
+	return CircuitBreakerName
+}
+
 // BeforeRequest checks if the circuit breaker is open
 func (c *CircuitBreakerInterceptor) BeforeRequest(data InterceptorData) (InterceptorData, error) {
 	if c.cb.State() == gobreaker.StateOpen {
@@ -51,12 +69,12 @@ func (c *CircuitBreakerInterceptor) BeforeRequest(data InterceptorData) (Interce
 	return data, nil
 }
 
-// AfterResponse records failures in the circuit breaker
+// AfterResponse records failures in the circuit breaker, leaving out calls that were never sent
 func (c *CircuitBreakerInterceptor) AfterResponse(data InterceptorData) (InterceptorData, error) {
-	if data.Response != nil {
+	if data.Response != nil && wasSent(data.Ctx) {
 		c.cb.Execute(func() (interface{}, error) {
 			// pass status code to IsSuccessful function
-			return nil, fmt.Errorf("%d", data.Response.StatusCode)
+			return nil, &BreakerStatusError{StatusCode: data.Response.StatusCode}
 		})
 
 		if data.Error != nil {
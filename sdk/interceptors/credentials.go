@@ -0,0 +1,235 @@
+package interceptors
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+)
+
+const (
+	// EnvSecretKey holds the secret key read by EnvCredentials.
+	EnvSecretKey = "SOTOON_SECRET_KEY"
+	// EnvCredentialsFile overrides the location of the credentials file.
+	EnvCredentialsFile = "SOTOON_CREDENTIALS_FILE"
+	// EnvProfile selects the profile read from the credentials file.
+	EnvProfile = "SOTOON_PROFILE"
+
+	DefaultProfile = "default"
+)
+
+// CredentialsProvider supplies the secret key used to authenticate requests.
+// It is called on every request, so implementations may rotate keys at any time.
+type CredentialsProvider interface {
+	// SecretKey returns the current secret key. Providers that have nothing to offer
+	// return an error wrapping constants.ErrNoCredentials.
+	SecretKey(ctx context.Context) (string, error)
+}
+
+/////////////////////////////////////////
+
+type staticCredentials struct {
+	secretKey string
+}
+
+// NewStaticCredentials always returns the given secret key. An empty key is sent as is, as
+// NewHandler and NewDefaultInterceptorTransport always did; NewSDK looks the key up with
+// NewDefaultCredentialsChain instead.
+func NewStaticCredentials(secretKey string) CredentialsProvider {
+	return &staticCredentials{secretKey: secretKey}
+}
+
+func (s *staticCredentials) SecretKey(ctx context.Context) (string, error) {
+	return s.secretKey, nil
+}
+
+/////////////////////////////////////////
+
+type envCredentials struct {
+	name string
+}
+
+// NewEnvCredentials reads the secret key from SOTOON_SECRET_KEY on every call.
+func NewEnvCredentials() CredentialsProvider {
+	return NewEnvVarCredentials(EnvSecretKey)
+}
+
+// NewEnvVarCredentials reads the secret key from the named environment variable on every call.
+func NewEnvVarCredentials(name string) CredentialsProvider {
+	return &envCredentials{name: name}
+}
+
+func (e *envCredentials) SecretKey(ctx context.Context) (string, error) {
+	secretKey := strings.TrimSpace(os.Getenv(e.name))
+	if secretKey == "" {
+		return "", fmt.Errorf("env %s: %w", e.name, constants.ErrNoCredentials)
+	}
+	return secretKey, nil
+}
+
+/////////////////////////////////////////
+
+// FileCredentials reads the secret key from an INI style credentials file:
+//
+//	[default]
+//	secret_key = ...
+//
+//	[staging]
+//	secret_key = ...
+//
+// The file is parsed again whenever its modification time changes.
+type FileCredentials struct {
+	path    string
+	profile string
+
+	mu      sync.Mutex
+	modTime time.Time
+	keys    map[string]string
+}
+
+// DefaultCredentialsFile returns SOTOON_CREDENTIALS_FILE or ~/.sotoon/credentials.
+func DefaultCredentialsFile() string {
+	if path := os.Getenv(EnvCredentialsFile); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".sotoon", "credentials")
+}
+
+// NewFileCredentials reads profile from the credentials file at path.
+// An empty path means DefaultCredentialsFile(), an empty profile means SOTOON_PROFILE or "default".
+func NewFileCredentials(path, profile string) *FileCredentials {
+	return &FileCredentials{
+		path:    path,
+		profile: profile,
+	}
+}
+
+func (f *FileCredentials) SecretKey(ctx context.Context) (string, error) {
+	path := f.path
+	if path == "" {
+		path = DefaultCredentialsFile()
+	}
+	profile := f.profile
+	if profile == "" {
+		profile = os.Getenv(EnvProfile)
+	}
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	keys, err := f.load(path)
+	if err != nil {
+		return "", err
+	}
+	secretKey := keys[profile]
+	if secretKey == "" {
+		return "", fmt.Errorf("file %s profile %q: %w", path, profile, constants.ErrNoCredentials)
+	}
+	return secretKey, nil
+}
+
+func (f *FileCredentials) load(path string) (map[string]string, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("file %s: %w", path, constants.ErrNoCredentials)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.keys != nil && info.ModTime().Equal(f.modTime) {
+		return f.keys, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := parseCredentialsFile(content)
+	if err != nil {
+		return nil, fmt.Errorf("file %s: %w", path, err)
+	}
+	f.keys = keys
+	f.modTime = info.ModTime()
+	return keys, nil
+}
+
+// parseCredentialsFile returns the secret_key of every profile in the file.
+func parseCredentialsFile(content []byte) (map[string]string, error) {
+	keys := map[string]string{}
+	profile := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			profile = strings.TrimSpace(line[1 : len(line)-1])
+		default:
+			name, value, found := strings.Cut(line, "=")
+			if !found {
+				return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+			}
+			if profile == "" {
+				return nil, fmt.Errorf("line %d: key outside of a [profile] section", lineNumber)
+			}
+			if strings.TrimSpace(name) == "secret_key" {
+				keys[profile] = strings.TrimSpace(value)
+			}
+		}
+	}
+	return keys, scanner.Err()
+}
+
+/////////////////////////////////////////
+
+type chainCredentials struct {
+	providers []CredentialsProvider
+}
+
+// NewChainCredentials tries each provider in turn and returns the first secret key found.
+// Providers failing with constants.ErrNoCredentials are skipped, any other error stops the chain.
+func NewChainCredentials(providers ...CredentialsProvider) CredentialsProvider {
+	return &chainCredentials{providers: providers}
+}
+
+func (c *chainCredentials) SecretKey(ctx context.Context) (string, error) {
+	var errs []error
+	for _, provider := range c.providers {
+		secretKey, err := provider.SecretKey(ctx)
+		if err == nil {
+			return secretKey, nil
+		}
+		if !errors.Is(err, constants.ErrNoCredentials) {
+			return "", err
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return "", constants.ErrNoCredentials
+	}
+	return "", errors.Join(errs...)
+}
+
+// NewDefaultCredentialsChain looks up SOTOON_SECRET_KEY first and then the default credentials file.
+func NewDefaultCredentialsChain() CredentialsProvider {
+	return NewChainCredentials(
+		NewEnvCredentials(),
+		NewFileCredentials("", ""),
+	)
+}
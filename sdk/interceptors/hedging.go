@@ -0,0 +1,217 @@
+package interceptors
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+type HedgingOptions struct {
+	// Delay is how long the first attempt runs alone before the hedge is sent. With
+	// Percentile set, it is used until enough latencies have been observed; zero then
+	// means no hedging until then.
+	Delay time.Duration
+	// Percentile, between 0 and 1 (e.g. 0.95), sends the hedge once the first attempt
+	// is slower than that percentile of the recent latencies of its operation.
+	Percentile float64
+	// Operations limits hedging to these operations, keyed by Operation.String()
+	// (e.g. "iam_v1.ListDetailedWorkspaceUsers"). Empty hedges every idempotent GET.
+	Operations []string
+	// OnHedge, if set, is called for every hedge sent, with whether it won the race,
+	// e.g. Metrics.Hedged.
+	OnHedge func(req *http.Request, won bool)
+}
+
+// hedgingWindow is the number of recent latencies per operation the percentile is taken over,
+// and hedgingMinSamples the number needed before it is used.
+const (
+	hedgingWindow     = 100
+	hedgingMinSamples = 20
+)
+
+// NewHedgingMiddleware sends a second attempt of slow idempotent GETs and uses whichever
+// response arrives first. The other attempt is cancelled and its body drained. If the
+// first attempt to finish failed, the other one is awaited.
+//
+// Hedging trades load for latency: enable it for the few reads that dominate tail latency,
+// with Operations. It is a middleware because it runs attempts concurrently; add it inside
+// a retry middleware, so each retry is hedged rather than each hedge retried.
+func NewHedgingMiddleware(opts HedgingOptions) Middleware {
+	h := &hedger{opts: opts, operations: map[string]bool{}, latencies: map[string]*latencyWindow{}}
+	for _, operation := range opts.Operations {
+		h.operations[operation] = true
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			key, ok := h.key(req)
+			if !ok {
+				return next.RoundTrip(req)
+			}
+			return h.roundTrip(req, key, next)
+		})
+	}
+}
+
+type hedger struct {
+	opts       HedgingOptions
+	operations map[string]bool
+
+	mu        sync.Mutex
+	latencies map[string]*latencyWindow
+}
+
+// key returns the key of the latencies of req, and false when req must not be hedged.
+func (h *hedger) key(req *http.Request) (string, bool) {
+	if req.Method != http.MethodGet || !IsIdempotent(req) || (req.Body != nil && req.Body != http.NoBody) {
+		return "", false
+	}
+	operation, known := OperationFromContext(req.Context())
+	if len(h.operations) > 0 && (!known || !h.operations[operation.String()]) {
+		return "", false
+	}
+	if !known {
+		return req.URL.Host, true
+	}
+	return operation.String(), true
+}
+
+type hedgeResult struct {
+	response *http.Response
+	err      error
+	hedge    bool
+	latency  time.Duration
+}
+
+func (h *hedger) roundTrip(req *http.Request, key string, next http.RoundTripper) (*http.Response, error) {
+	results := make(chan hedgeResult, 2)
+	cancels := map[bool]context.CancelFunc{}
+	send := func(hedge bool) error {
+		ctx, cancel := context.WithCancel(req.Context())
+		attempt, err := cloneForRetry(req, ctx)
+		if err != nil {
+			cancel()
+			return err
+		}
+		cancels[hedge] = cancel
+		go func() {
+			start := time.Now()
+			response, err := next.RoundTrip(attempt)
+			results <- hedgeResult{response: response, err: err, hedge: hedge, latency: time.Since(start)}
+		}()
+		return nil
+	}
+
+	if err := send(false); err != nil {
+		return nil, err
+	}
+	var timeout <-chan time.Time
+	if delay := h.delay(key); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	hedged := false
+	var attemptErr error
+	for {
+		select {
+		case <-timeout:
+			timeout = nil
+			hedged = send(true) == nil
+		case result := <-results:
+			if result.err != nil {
+				cancels[result.hedge]()
+				delete(cancels, result.hedge)
+				if !result.hedge || attemptErr == nil {
+					attemptErr = result.err
+				}
+				if len(cancels) > 0 {
+					// the other attempt may still succeed
+					continue
+				}
+				if hedged && h.opts.OnHedge != nil {
+					h.opts.OnHedge(req, false)
+				}
+				return nil, attemptErr
+			}
+
+			h.observe(key, result.latency)
+			if hedged && h.opts.OnHedge != nil {
+				h.opts.OnHedge(req, result.hedge)
+			}
+			if cancelLoser, ok := cancels[!result.hedge]; ok {
+				cancelLoser()
+				go drainLoser(results)
+			}
+			result.response.Body = &cancelOnCloseBody{ReadCloser: result.response.Body, cancel: cancels[result.hedge]}
+			return result.response, nil
+		}
+	}
+}
+
+// drainLoser drains the response of the cancelled attempt that lost the race, if it got one.
+func drainLoser(results <-chan hedgeResult) {
+	discardBody((<-results).response)
+}
+
+// delay returns how long to wait before hedging a call of key.
+func (h *hedger) delay(key string) time.Duration {
+	if h.opts.Percentile <= 0 || h.opts.Percentile >= 1 {
+		return h.opts.Delay
+	}
+	h.mu.Lock()
+	window, ok := h.latencies[key]
+	h.mu.Unlock()
+	if !ok {
+		return h.opts.Delay
+	}
+	if delay, ok := window.percentile(h.opts.Percentile); ok {
+		return delay
+	}
+	return h.opts.Delay
+}
+
+func (h *hedger) observe(key string, latency time.Duration) {
+	if h.opts.Percentile <= 0 {
+		return
+	}
+	h.mu.Lock()
+	window, ok := h.latencies[key]
+	if !ok {
+		window = &latencyWindow{}
+		h.latencies[key] = window
+	}
+	h.mu.Unlock()
+	window.add(latency)
+}
+
+// latencyWindow keeps the last hedgingWindow latencies of an operation.
+type latencyWindow struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+func (w *latencyWindow) add(latency time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.samples) < hedgingWindow {
+		w.samples = append(w.samples, latency)
+		return
+	}
+	w.samples[w.next] = latency
+	w.next = (w.next + 1) % hedgingWindow
+}
+
+func (w *latencyWindow) percentile(p float64) (time.Duration, bool) {
+	w.mu.Lock()
+	samples := append([]time.Duration(nil), w.samples...)
+	w.mu.Unlock()
+	if len(samples) < hedgingMinSamples {
+		return 0, false
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	return samples[int(p*float64(len(samples)-1))], true
+}
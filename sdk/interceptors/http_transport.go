@@ -0,0 +1,47 @@
+package interceptors
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// sharedHTTPTransport is used by every InterceptorTransport that does not get its own
+// RoundTripper, so connections are reused across all service handlers.
+var sharedHTTPTransport = NewHTTPTransport()
+
+// NewHTTPTransport returns a clone of http.DefaultTransport tuned for talking to
+// a small number of API hosts with many concurrent requests.
+func NewHTTPTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = 100
+	transport.MaxIdleConnsPerHost = 100
+	transport.IdleConnTimeout = 90 * time.Second
+	return transport
+}
+
+// SetTransport replaces the underlying RoundTripper used to send requests. The caller
+// keeps ownership of rt: Close leaves its idle connections alone.
+func (it *InterceptorTransport) SetTransport(rt http.RoundTripper) {
+	it.rt = rt
+	it.ownsTransport = false
+}
+
+// Transport returns the underlying RoundTripper used to send requests.
+func (it *InterceptorTransport) Transport() http.RoundTripper {
+	return it.rt
+}
+
+// ConfigureHTTPTransport clones the underlying *http.Transport, applies configure to the
+// clone and installs it. The shared transport is never mutated in place.
+func (it *InterceptorTransport) ConfigureHTTPTransport(configure func(*http.Transport)) error {
+	transport, ok := it.rt.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("underlying transport is %T, not *http.Transport", it.rt)
+	}
+	transport = transport.Clone()
+	configure(transport)
+	it.rt = transport
+	it.ownsTransport = true
+	return nil
+}
@@ -0,0 +1,63 @@
+package interceptors
+
+import (
+	"net/http"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
+)
+
+// IdempotencyKeyHeader lets the server recognize a resent call and answer it without
+// performing it twice.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyKeyInterceptor sets an Idempotency-Key header on calls that are not idempotent,
+// such as the POSTs creating resources. The key is the one set with callopts.IdempotencyKey,
+// or the ID of the call, so it stays the same across retries of the same logical call.
+type IdempotencyKeyInterceptor struct{}
+
+func NewIdempotencyKeyInterceptor() *IdempotencyKeyInterceptor {
+	return &IdempotencyKeyInterceptor{}
+}
+
+func (i *IdempotencyKeyInterceptor) Name() string {
+	return IdempotencyKeyName
+}
+
+func (i *IdempotencyKeyInterceptor) BeforeRequest(data InterceptorData) (InterceptorData, error) {
+	if IsIdempotent(data.Request) || data.Request.Header.Get(IdempotencyKeyHeader) != "" {
+		return data, nil
+	}
+
+	key := callopts.FromContext(data.Ctx).IdempotencyKey
+	if key == "" {
+		key = data.ID
+	}
+	data.Request.Header.Set(IdempotencyKeyHeader, key)
+	// retries resend InitialRequest, which must carry the same key
+	if data.InitialRequest != nil {
+		setHeader(data.InitialRequest, IdempotencyKeyHeader, key)
+	}
+	return data, nil
+}
+
+func (i *IdempotencyKeyInterceptor) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	return data, nil
+}
+
+// IsRetrySafe reports whether req may be sent again: it is idempotent, it carries an
+// Idempotency-Key, or the caller allowed unsafe retries with callopts.AllowUnsafeRetry.
+func IsRetrySafe(req *http.Request) bool {
+	return IsIdempotent(req) ||
+		req.Header.Get(IdempotencyKeyHeader) != "" ||
+		callopts.FromContext(req.Context()).AllowUnsafeRetry
+}
+
+// setHeader sets a header without changing the header map shared with other copies of req.
+func setHeader(req *http.Request, key, value string) {
+	header := req.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set(key, value)
+	req.Header = header
+}
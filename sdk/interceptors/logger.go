@@ -7,6 +7,8 @@ import (
 	"log"
 	"net/http"
 	"strings"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
 )
 
 // LoggerOptions defines configuration options for the logger interceptor
@@ -25,11 +27,14 @@ type LoggerOptions struct {
 	SkipHeaders []string
 	// SkipPaths is a list of URL paths to exclude from logging
 	SkipPaths []string
+	// Redaction controls how secrets are hidden from logged headers and bodies
+	Redaction RedactionOptions
 }
 
 // Logger is an interceptor that logs HTTP requests and responses
 type Logger struct {
-	opts LoggerOptions
+	opts     LoggerOptions
+	redactor *Redactor
 }
 
 // NewLogger creates a new logger interceptor with the given options
@@ -47,16 +52,17 @@ func NewLogger(opts LoggerOptions) *Logger {
 		opts.SkipHeaders[i] = strings.ToLower(header)
 	}
 
-	return &Logger{opts: opts}
+	return &Logger{opts: opts, redactor: NewRedactor(opts.Redaction)}
+}
+
+func (l *Logger) Name() string {
+	return LoggerName
 }
 
 // BeforeRequest logs the outgoing request
 func (l *Logger) BeforeRequest(data InterceptorData) (InterceptorData, error) {
-	// Skip logging for specified paths
-	for _, path := range l.opts.SkipPaths {
-		if strings.HasPrefix(data.Request.URL.Path, path) {
-			return data, nil
-		}
+	if l.shouldSkip(data) {
+		return data, nil
 	}
 
 	var logBuilder strings.Builder
@@ -79,14 +85,9 @@ func (l *Logger) BeforeRequest(data InterceptorData) (InterceptorData, error) {
 		} else {
 			data.Request.Body = io.NopCloser(bytes.NewReader(body))
 
-			// Truncate body if it's too large
-			truncated := false
-			if len(body) > l.opts.MaxBodyLogSize {
-				body = body[:l.opts.MaxBodyLogSize]
-				truncated = true
-			}
-			logBuilder.WriteString(fmt.Sprintf("[%s] REQ BODY: %s%s\n", data.ID, string(body),
-				map[bool]string{true: " [truncated...]", false: ""}[truncated]))
+			// Redact before truncating: a cut JSON body could not be parsed
+			logged := l.redactor.Body(data.Ctx, body, data.Request.Header.Get("Content-Type"))
+			logBuilder.WriteString(fmt.Sprintf("[%s] REQ BODY: %s\n", data.ID, truncateLog(logged, l.opts.MaxBodyLogSize)))
 
 		}
 	}
@@ -101,11 +102,15 @@ func (l *Logger) BeforeRequest(data InterceptorData) (InterceptorData, error) {
 
 // AfterResponse logs the received response
 func (l *Logger) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	if l.shouldSkip(data) {
+		return data, nil
+	}
 
-	for _, path := range l.opts.SkipPaths {
-		if strings.HasPrefix(data.Request.URL.Path, path) {
-			return data, nil
+	if data.Response == nil {
+		if l.opts.LogBasicInfo && data.Error != nil {
+			l.opts.Logger.Printf("[%s] <-- error: %v\n", data.ID, data.Error)
 		}
+		return data, nil
 	}
 
 	var logBuilder strings.Builder
@@ -128,14 +133,8 @@ func (l *Logger) AfterResponse(data InterceptorData) (InterceptorData, error) {
 		} else {
 			data.Response.Body = io.NopCloser(bytes.NewReader(body))
 
-			// Truncate body if it's too large
-			truncated := false
-			if len(body) > l.opts.MaxBodyLogSize {
-				body = body[:l.opts.MaxBodyLogSize]
-				truncated = true
-			}
-			logBuilder.WriteString(fmt.Sprintf("[%s] RESP BODY: %s%s\n", data.ID, string(body),
-				map[bool]string{true: " [truncated...]", false: ""}[truncated]))
+			logged := l.redactor.Body(data.Ctx, body, data.Response.Header.Get("Content-Type"))
+			logBuilder.WriteString(fmt.Sprintf("[%s] RESP BODY: %s\n", data.ID, truncateLog(logged, l.opts.MaxBodyLogSize)))
 		}
 	}
 
@@ -147,6 +146,24 @@ func (l *Logger) AfterResponse(data InterceptorData) (InterceptorData, error) {
 	return data, nil
 }
 
+// shouldSkip reports whether the call asked not to be logged or its path is skipped
+func (l *Logger) shouldSkip(data InterceptorData) bool {
+	return skipLogging(data, l.opts.SkipPaths)
+}
+
+// skipLogging reports whether the call set callopts.SkipLogging or its path starts with one of skipPaths
+func skipLogging(data InterceptorData, skipPaths []string) bool {
+	if callopts.FromContext(data.Ctx).SkipLogging {
+		return true
+	}
+	for _, path := range skipPaths {
+		if strings.HasPrefix(data.Request.URL.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
 // buildHeaderLogs builds a string containing all header logs
 func (l *Logger) buildHeaderLogs(prefix string, id string, headers http.Header) string {
 	var logBuilder strings.Builder
@@ -156,7 +173,7 @@ func (l *Logger) buildHeaderLogs(prefix string, id string, headers http.Header)
 		}
 
 		for _, value := range values {
-			logBuilder.WriteString(fmt.Sprintf("[%s] %s HEADER: %s: %s\n", id, prefix, name, value))
+			logBuilder.WriteString(fmt.Sprintf("[%s] %s HEADER: %s: %s\n", id, prefix, name, l.redactor.Header(name, value)))
 		}
 	}
 	return logBuilder.String()
@@ -164,11 +181,24 @@ func (l *Logger) buildHeaderLogs(prefix string, id string, headers http.Header)
 
 // shouldSkipHeader determines if a header should be skipped in logs
 func (l *Logger) shouldSkipHeader(name string) bool {
+	return headerSkipped(name, l.opts.SkipHeaders)
+}
+
+// headerSkipped reports whether name is in skipHeaders, which must be lowercase
+func headerSkipped(name string, skipHeaders []string) bool {
 	lowerName := strings.ToLower(name)
-	for _, skip := range l.opts.SkipHeaders {
+	for _, skip := range skipHeaders {
 		if skip == lowerName {
 			return true
 		}
 	}
 	return false
 }
+
+// truncateLog cuts body to maxSize bytes, marking the cut
+func truncateLog(body string, maxSize int) string {
+	if len(body) > maxSize {
+		return body[:maxSize] + " [truncated...]"
+	}
+	return body
+}
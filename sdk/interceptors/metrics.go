@@ -0,0 +1,134 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sony/gobreaker"
+)
+
+// MetricLabels identify the calls a measurement is about. They only hold values of low
+// cardinality: the operation names the endpoint, never the raw URL with its UUIDs.
+type MetricLabels struct {
+	Service   string
+	Operation string
+	Method    string
+}
+
+// UnknownLabel stands for the service and operation of calls to unknown endpoints.
+const UnknownLabel = "unknown"
+
+// MetricLabelsFor returns the labels of req, read from its operation.
+func MetricLabelsFor(req *http.Request) MetricLabels {
+	labels := MetricLabels{Service: UnknownLabel, Operation: UnknownLabel, Method: req.Method}
+	if operation, ok := OperationFromContext(req.Context()); ok {
+		labels.Service = operation.Service
+		labels.Operation = operation.Name
+	}
+	return labels
+}
+
+// MetricsSink receives the measurements of a Metrics interceptor and turns them into the
+// metrics of a backend. The promsink and otelsink packages adapt Prometheus and
+// OpenTelemetry. Implementations must be safe for concurrent use.
+type MetricsSink interface {
+	// RequestDone records a finished call and its latency. statusClass is "1xx" to "5xx",
+	// or "error" for calls that got no response.
+	RequestDone(ctx context.Context, labels MetricLabels, statusClass string, latency time.Duration)
+	// RequestRetried records the resends of a call, when it was sent more than once.
+	RequestRetried(ctx context.Context, labels MetricLabels, retries int)
+	// RateLimitWaited records how long a call waited for a RateLimiter.
+	RateLimitWaited(ctx context.Context, labels MetricLabels, wait time.Duration)
+	// RequestHedged records a hedge sent by the hedging middleware, and whether it won.
+	RequestHedged(ctx context.Context, labels MetricLabels, won bool)
+	// BreakerStateChanged records a transition of the breaker for key.
+	BreakerStateChanged(key string, from, to gobreaker.State)
+}
+
+// Metrics is an interceptor recording the count, outcome, latency and resends of every
+// call in a MetricsSink. Add it after the retry interceptor, so it sees the final outcome of
+// retries, and before interceptors that may reject calls, such as a rate limiter: their
+// rejections reach its AfterResponse and count as "error". Breakers run as middlewares, so
+// their rejections are always counted. Rate-limiter waits, hedges and breaker transitions
+// reach the sink through callbacks:
+//
+//	metrics := interceptors.NewMetrics(sink)
+//	limiter := interceptors.NewRateLimiter(interceptors.RateLimiterOptions{..., OnWait: metrics.RateLimitWaited})
+//	hedging := interceptors.NewHedgingMiddleware(interceptors.HedgingOptions{..., OnHedge: metrics.Hedged})
+//	registry := interceptors.NewCircuitBreakerRegistry(interceptors.CircuitBreakerRegistryOptions{OnStateChange: metrics.BreakerStateChanged})
+type Metrics struct {
+	sink MetricsSink
+}
+
+func NewMetrics(sink MetricsSink) *Metrics {
+	return &Metrics{sink: sink}
+}
+
+func (m *Metrics) Name() string {
+	return MetricsName
+}
+
+type metricsStartKey struct {
+	metrics *Metrics
+}
+
+// BeforeRequest notes the start of the call. Resends of a RetryInterceptor run the chain
+// again; they are part of the call they resend and are not counted on their own.
+func (m *Metrics) BeforeRequest(data InterceptorData) (InterceptorData, error) {
+	if isRetryAttempt(data.Ctx) {
+		return data, nil
+	}
+	data.Ctx = context.WithValue(data.Ctx, metricsStartKey{m}, time.Now())
+	data.Request = data.Request.WithContext(data.Ctx)
+	return data, nil
+}
+
+// AfterResponse records the call.
+func (m *Metrics) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	start, ok := data.Ctx.Value(metricsStartKey{m}).(time.Time)
+	if !ok {
+		return data, nil
+	}
+
+	labels := MetricLabelsFor(data.Request)
+	m.sink.RequestDone(data.Ctx, labels, StatusClass(data.Response, data.Error), time.Since(start))
+	if attempts := AttemptsFromContext(data.Ctx); attempts > 1 {
+		m.sink.RequestRetried(data.Ctx, labels, attempts-1)
+	}
+	return data, nil
+}
+
+// RateLimitWaited passes the wait of req to the sink. Set it as RateLimiterOptions.OnWait.
+func (m *Metrics) RateLimitWaited(req *http.Request, wait time.Duration) {
+	m.sink.RateLimitWaited(req.Context(), MetricLabelsFor(req), wait)
+}
+
+// Hedged passes a hedge of req to the sink. Set it as HedgingOptions.OnHedge.
+func (m *Metrics) Hedged(req *http.Request, won bool) {
+	m.sink.RequestHedged(req.Context(), MetricLabelsFor(req), won)
+}
+
+// BreakerStateChanged passes the transition to the sink. Set it as
+// CircuitBreakerRegistryOptions.OnStateChange.
+func (m *Metrics) BreakerStateChanged(key string, from, to gobreaker.State) {
+	m.sink.BreakerStateChanged(key, from, to)
+}
+
+// StatusClass returns the class of the response status, e.g. "2xx", that of the *APIError
+// of a call whose response was dropped, or "error" when a failed call got no response.
+func StatusClass(response *http.Response, err error) string {
+	if response == nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			return strconv.Itoa(apiErr.StatusCode/100) + "xx"
+		}
+		if err != nil {
+			return "error"
+		}
+		return UnknownLabel
+	}
+	return strconv.Itoa(response.StatusCode/100) + "xx"
+}
@@ -0,0 +1,119 @@
+package interceptors
+
+import (
+	"context"
+	"net/http"
+)
+
+// Middleware wraps the RoundTripper that sends a request. Unlike an Interceptor it sees the
+// whole call, so it can time it, call next several times (retry) or recover from failures.
+//
+// Middlewares run after every BeforeRequest and before every AfterResponse of the interceptor
+// chain. The first middleware added is the outermost: requests pass through the middlewares in
+// the order they were added and responses unwind in reverse order.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a plain function to http.RoundTripper.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the ID InterceptorTransport assigned to the request, so
+// middlewares can correlate with interceptors (InterceptorData.ID).
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func withRequestID(ctx context.Context, id string) context.Context {
+	if RequestIDFromContext(ctx) == id {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// InterceptorMiddleware runs an existing Interceptor as a Middleware: BeforeRequest before
+// calling next and AfterResponse on the way back, so it unwinds like any other middleware.
+func InterceptorMiddleware(interceptor Interceptor) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			data := InterceptorData{
+				ID:             RequestIDFromContext(req.Context()),
+				Ctx:            req.Context(),
+				InitialRequest: req.Clone(req.Context()),
+				Request:        req,
+			}
+
+			data, err := interceptor.BeforeRequest(data)
+			if err != nil {
+				return nil, err
+			}
+			if data.Response != nil {
+				return data.Response, nil
+			}
+			if data.Error != nil {
+				return nil, data.Error
+			}
+
+			data.Response, data.Error = next.RoundTrip(data.Request)
+			data, err = interceptor.AfterResponse(data)
+			if err != nil {
+				return nil, err
+			}
+			if data.Error != nil {
+				return nil, data.Error
+			}
+			return data.Response, nil
+		})
+	}
+}
+
+func AppendMiddlewares(middlewares ...Middleware) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		it.AddMiddlewares(middlewares...)
+		return nil
+	}
+}
+
+// AddMiddlewares adds the middlewares inside the ones already added.
+func (it *InterceptorTransport) AddMiddlewares(middlewares ...Middleware) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.middlewares = append(append([]Middleware{}, it.middlewares...), middlewares...)
+}
+
+// send passes the request through the middlewares to the underlying transport, counting
+// every request that reaches it as an attempt of the call and bounding it by the
+// per-attempt timeout of the call.
+func (it *InterceptorTransport) send(req *http.Request) (*http.Response, error) {
+	it.mu.RLock()
+	base, middlewares := it.rt, it.middlewares
+	it.mu.RUnlock()
+
+	perAttempt := it.timeoutsOf(req).PerAttempt
+	rt := http.RoundTripper(RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if state, ok := req.Context().Value(callStateKey{}).(*callState); ok {
+			state.attempts.Add(1)
+		}
+		if perAttempt <= 0 {
+			return base.RoundTrip(req)
+		}
+
+		req, cancel := withDeadline(req, perAttempt, TimeoutLimitAttempt)
+		resp, err := base.RoundTrip(req)
+		if err != nil {
+			cancel()
+			return nil, deadlineError(req.Context(), err)
+		}
+		resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+		return resp, nil
+	}))
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+	return rt.RoundTrip(req)
+}
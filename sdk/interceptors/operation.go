@@ -0,0 +1,141 @@
+package interceptors
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Operation describes one generated API operation.
+type Operation struct {
+	Service      string // e.g., "iam_v1"
+	Name         string // e.g., "CreateGroup"
+	Method       string
+	PathTemplate string // e.g., "/iam/v1/api/v1/workspace/{workspaceUUID}/group/"
+	// Idempotent tells whether sending the call twice has the same effect as sending it once.
+	// It follows the HTTP method unless the generator's idempotency overrides say otherwise.
+	Idempotent bool
+	// SensitiveFields are the JSON fields of the service's schemas that hold secrets, such as
+	// "password" or "client_secret". Loggers redact them from bodies.
+	SensitiveFields []string
+}
+
+// String returns "<service>.<name>", e.g. "iam_v1.CreateGroup", the key used for
+// per-operation settings.
+func (o Operation) String() string {
+	return o.Service + "." + o.Name
+}
+
+// IsIdempotentMethod reports whether requests with method can be repeated safely (RFC 9110).
+func IsIdempotentMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+type operationKey struct{}
+
+// OperationFromContext returns the operation InterceptorTransport matched the request to.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	if ctx == nil {
+		return Operation{}, false
+	}
+	operation, ok := ctx.Value(operationKey{}).(Operation)
+	return operation, ok
+}
+
+// IsIdempotent reports whether req can be sent again without risking a duplicate: by its
+// operation when known, by its method otherwise.
+func IsIdempotent(req *http.Request) bool {
+	if operation, ok := OperationFromContext(req.Context()); ok {
+		return operation.Idempotent
+	}
+	return IsIdempotentMethod(req.Method)
+}
+
+// RegisterOperations adds operations the transport matches requests against. Handlers
+// register the generated Operations of their service.
+func (it *InterceptorTransport) RegisterOperations(operations ...Operation) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	matchers := append([]operationMatcher{}, it.operations...)
+	for _, operation := range operations {
+		matchers = append(matchers, newOperationMatcher(operation))
+	}
+	it.operations = matchers
+}
+
+// MatchOperation returns the registered operation req was built for. The path may carry
+// a prefix from the server address. When several templates match, the one with the most
+// literal segments wins, so /user/otp/ beats /user/{userUUID}/.
+func (it *InterceptorTransport) MatchOperation(req *http.Request) (Operation, bool) {
+	it.mu.RLock()
+	matchers := it.operations
+	it.mu.RUnlock()
+
+	segments := splitPath(req.URL.Path)
+	best, bestScore := -1, -1
+	for i, matcher := range matchers {
+		if matcher.operation.Method != req.Method {
+			continue
+		}
+		if score, ok := matcher.match(segments); ok && score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	if best < 0 {
+		return Operation{}, false
+	}
+	return matchers[best].operation, true
+}
+
+// withOperation stores the matched operation of req in its context, keeping one that is
+// already there (e.g. on attempts resent by a retry through another transport).
+func (it *InterceptorTransport) withOperation(req *http.Request) *http.Request {
+	if _, ok := OperationFromContext(req.Context()); ok {
+		return req
+	}
+	operation, ok := it.MatchOperation(req)
+	if !ok {
+		return req
+	}
+	return req.WithContext(context.WithValue(req.Context(), operationKey{}, operation))
+}
+
+type operationMatcher struct {
+	operation Operation
+	segments  []string // "" matches any segment
+	literals  int
+}
+
+func newOperationMatcher(operation Operation) operationMatcher {
+	matcher := operationMatcher{operation: operation, segments: splitPath(operation.PathTemplate)}
+	for i, segment := range matcher.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			matcher.segments[i] = ""
+		} else {
+			matcher.literals++
+		}
+	}
+	return matcher
+}
+
+// match compares the template with the end of the request path.
+func (m operationMatcher) match(path []string) (int, bool) {
+	if len(path) < len(m.segments) {
+		return 0, false
+	}
+	path = path[len(path)-len(m.segments):]
+	for i, segment := range m.segments {
+		if segment != "" && segment != path[i] {
+			return 0, false
+		}
+	}
+	return m.literals, true
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
@@ -0,0 +1,115 @@
+// Package otelsink records the measurements of an interceptors.Metrics with the
+// OpenTelemetry metrics API.
+package otelsink
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+// ScopeName is the instrumentation scope of the instruments.
+const ScopeName = "github.com/sotoon/sotoon-sdk-go/sdk/interceptors/otelsink"
+
+// Attributes of the measurements.
+const (
+	ServiceKey          = attribute.Key("sotoon.service")
+	OperationKey        = attribute.Key("sotoon.operation")
+	MethodKey           = attribute.Key("http.request.method")
+	StatusClassKey      = attribute.Key("sotoon.status_class")
+	HedgeWonKey         = attribute.Key("sotoon.hedge.won")
+	BreakerKey          = attribute.Key("sotoon.circuit_breaker.key")
+	BreakerFromStateKey = attribute.Key("sotoon.circuit_breaker.from")
+	BreakerToStateKey   = attribute.Key("sotoon.circuit_breaker.to")
+)
+
+type Options struct {
+	// MeterProvider creates the meter. Defaults to otel.GetMeterProvider().
+	MeterProvider metric.MeterProvider
+}
+
+// Sink is an interceptors.MetricsSink recording the instruments sotoon.sdk.requests,
+// sotoon.sdk.request.duration, sotoon.sdk.retries, sotoon.sdk.rate_limit.wait,
+// sotoon.sdk.hedges and sotoon.sdk.circuit_breaker.transitions.
+type Sink struct {
+	requests           metric.Int64Counter
+	duration           metric.Float64Histogram
+	retries            metric.Int64Counter
+	rateLimitWait      metric.Float64Histogram
+	hedges             metric.Int64Counter
+	breakerTransitions metric.Int64Counter
+}
+
+// New creates the instruments.
+func New(opts Options) (*Sink, error) {
+	if opts.MeterProvider == nil {
+		opts.MeterProvider = otel.GetMeterProvider()
+	}
+	meter := opts.MeterProvider.Meter(ScopeName, metric.WithInstrumentationVersion(constants.SDKVersion))
+
+	var s Sink
+	var err, errs error
+	s.requests, err = meter.Int64Counter("sotoon.sdk.requests",
+		metric.WithDescription("Calls to Sotoon APIs, by outcome."), metric.WithUnit("{request}"))
+	errs = errors.Join(errs, err)
+	s.duration, err = meter.Float64Histogram("sotoon.sdk.request.duration",
+		metric.WithDescription("Latency of calls to Sotoon APIs, including retries."), metric.WithUnit("s"))
+	errs = errors.Join(errs, err)
+	s.retries, err = meter.Int64Counter("sotoon.sdk.retries",
+		metric.WithDescription("Resends of calls to Sotoon APIs."), metric.WithUnit("{request}"))
+	errs = errors.Join(errs, err)
+	s.rateLimitWait, err = meter.Float64Histogram("sotoon.sdk.rate_limit.wait",
+		metric.WithDescription("Time calls waited for the client-side rate limiter."), metric.WithUnit("s"))
+	errs = errors.Join(errs, err)
+	s.hedges, err = meter.Int64Counter("sotoon.sdk.hedges",
+		metric.WithDescription("Hedged attempts sent for slow calls, by whether they won."), metric.WithUnit("{request}"))
+	errs = errors.Join(errs, err)
+	s.breakerTransitions, err = meter.Int64Counter("sotoon.sdk.circuit_breaker.transitions",
+		metric.WithDescription("State changes of circuit breakers."), metric.WithUnit("{transition}"))
+	errs = errors.Join(errs, err)
+	if errs != nil {
+		return nil, errs
+	}
+	return &s, nil
+}
+
+func (s *Sink) RequestDone(ctx context.Context, labels interceptors.MetricLabels, statusClass string, latency time.Duration) {
+	attrs := metric.WithAttributes(append(callAttributes(labels), StatusClassKey.String(statusClass))...)
+	s.requests.Add(ctx, 1, attrs)
+	s.duration.Record(ctx, latency.Seconds(), attrs)
+}
+
+func (s *Sink) RequestRetried(ctx context.Context, labels interceptors.MetricLabels, retries int) {
+	s.retries.Add(ctx, int64(retries), metric.WithAttributes(callAttributes(labels)...))
+}
+
+func (s *Sink) RateLimitWaited(ctx context.Context, labels interceptors.MetricLabels, wait time.Duration) {
+	s.rateLimitWait.Record(ctx, wait.Seconds(), metric.WithAttributes(callAttributes(labels)...))
+}
+
+func (s *Sink) RequestHedged(ctx context.Context, labels interceptors.MetricLabels, won bool) {
+	s.hedges.Add(ctx, 1, metric.WithAttributes(append(callAttributes(labels), HedgeWonKey.Bool(won))...))
+}
+
+func (s *Sink) BreakerStateChanged(key string, from, to gobreaker.State) {
+	s.breakerTransitions.Add(context.Background(), 1, metric.WithAttributes(
+		BreakerKey.String(key),
+		BreakerFromStateKey.String(from.String()),
+		BreakerToStateKey.String(to.String())))
+}
+
+func callAttributes(labels interceptors.MetricLabels) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		ServiceKey.String(labels.Service),
+		OperationKey.String(labels.Operation),
+		MethodKey.String(labels.Method),
+	}
+}
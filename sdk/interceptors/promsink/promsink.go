@@ -0,0 +1,120 @@
+// Package promsink records the measurements of an interceptors.Metrics in Prometheus.
+package promsink
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sony/gobreaker"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+type Options struct {
+	// Namespace prefixes the metric names. Default "sotoon_sdk".
+	Namespace string
+	// Registerer registers the metrics. Default prometheus.DefaultRegisterer.
+	Registerer prometheus.Registerer
+	// Buckets of the latency histograms, in seconds. Default prometheus.DefBuckets.
+	Buckets []float64
+}
+
+// Sink is an interceptors.MetricsSink exporting:
+//
+//   - <namespace>_requests_total{service, operation, method, status_class}
+//   - <namespace>_request_duration_seconds{service, operation, method, status_class}
+//   - <namespace>_retries_total{service, operation, method}
+//   - <namespace>_rate_limit_wait_seconds{service, operation, method}
+//   - <namespace>_hedges_total{service, operation, method, won}
+//   - <namespace>_circuit_breaker_transitions_total{breaker, from, to}
+type Sink struct {
+	requests           *prometheus.CounterVec
+	duration           *prometheus.HistogramVec
+	retries            *prometheus.CounterVec
+	rateLimitWait      *prometheus.HistogramVec
+	hedges             *prometheus.CounterVec
+	breakerTransitions *prometheus.CounterVec
+}
+
+var (
+	callLabels    = []string{"service", "operation", "method"}
+	requestLabels = []string{"service", "operation", "method", "status_class"}
+)
+
+// New creates the metrics and registers them.
+func New(opts Options) (*Sink, error) {
+	if opts.Namespace == "" {
+		opts.Namespace = "sotoon_sdk"
+	}
+	if opts.Registerer == nil {
+		opts.Registerer = prometheus.DefaultRegisterer
+	}
+	if opts.Buckets == nil {
+		opts.Buckets = prometheus.DefBuckets
+	}
+
+	s := &Sink{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: opts.Namespace,
+			Name:      "requests_total",
+			Help:      "Calls to Sotoon APIs, by outcome.",
+		}, requestLabels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: opts.Namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of calls to Sotoon APIs, including retries.",
+			Buckets:   opts.Buckets,
+		}, requestLabels),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: opts.Namespace,
+			Name:      "retries_total",
+			Help:      "Resends of calls to Sotoon APIs.",
+		}, callLabels),
+		rateLimitWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: opts.Namespace,
+			Name:      "rate_limit_wait_seconds",
+			Help:      "Time calls waited for the client-side rate limiter.",
+			Buckets:   opts.Buckets,
+		}, callLabels),
+		hedges: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: opts.Namespace,
+			Name:      "hedges_total",
+			Help:      "Hedged attempts sent for slow calls, by whether they won.",
+		}, []string{"service", "operation", "method", "won"}),
+		breakerTransitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: opts.Namespace,
+			Name:      "circuit_breaker_transitions_total",
+			Help:      "State changes of circuit breakers.",
+		}, []string{"breaker", "from", "to"}),
+	}
+
+	for _, collector := range []prometheus.Collector{s.requests, s.duration, s.retries, s.rateLimitWait, s.hedges, s.breakerTransitions} {
+		if err := opts.Registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *Sink) RequestDone(_ context.Context, labels interceptors.MetricLabels, statusClass string, latency time.Duration) {
+	s.requests.WithLabelValues(labels.Service, labels.Operation, labels.Method, statusClass).Inc()
+	s.duration.WithLabelValues(labels.Service, labels.Operation, labels.Method, statusClass).Observe(latency.Seconds())
+}
+
+func (s *Sink) RequestRetried(_ context.Context, labels interceptors.MetricLabels, retries int) {
+	s.retries.WithLabelValues(labels.Service, labels.Operation, labels.Method).Add(float64(retries))
+}
+
+func (s *Sink) RateLimitWaited(_ context.Context, labels interceptors.MetricLabels, wait time.Duration) {
+	s.rateLimitWait.WithLabelValues(labels.Service, labels.Operation, labels.Method).Observe(wait.Seconds())
+}
+
+func (s *Sink) RequestHedged(_ context.Context, labels interceptors.MetricLabels, won bool) {
+	s.hedges.WithLabelValues(labels.Service, labels.Operation, labels.Method, strconv.FormatBool(won)).Inc()
+}
+
+func (s *Sink) BreakerStateChanged(key string, from, to gobreaker.State) {
+	s.breakerTransitions.WithLabelValues(key, from.String(), to.String()).Inc()
+}
@@ -0,0 +1,259 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit is a token bucket: Rate requests per second on average, in bursts of up to Burst.
+// A zero Burst allows bursts of Rate requests (at least one).
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimiterOptions configures a RateLimiter. A call waits for a token from every limit
+// that applies to it: the global one, the one of its service and the one of its operation.
+type RateLimiterOptions struct {
+	// Global limits all calls. A zero Rate means no global limit.
+	Global RateLimit
+	// Services limits the calls of a service, keyed by its ServiceName (e.g. "iam_v1").
+	Services map[string]RateLimit
+	// Operations limits the calls of an operation, keyed by Operation.String() (e.g. "iam_v1.CreateGroup").
+	Operations map[string]RateLimit
+	// Adaptive lowers the rates when the server answers 429 or reports a low quota. Nil keeps
+	// the rates fixed.
+	Adaptive *AdaptiveRateLimit
+	// OnWait, if set, is called with how long each limited call waited, e.g. Metrics.RateLimitWaited.
+	OnWait func(req *http.Request, wait time.Duration)
+}
+
+// AdaptiveRateLimit controls how a RateLimiter slows down when the server pushes back.
+type AdaptiveRateLimit struct {
+	// DecreaseFactor multiplies the rate on every 429. Default 0.5.
+	DecreaseFactor float64
+	// MinFraction is the lowest fraction of the configured rate adaptation goes down to. Default 0.1.
+	MinFraction float64
+	// RecoveryInterval is how long the rate stays lowered before doubling back toward the
+	// configured rate. Default 10s.
+	RecoveryInterval time.Duration
+}
+
+// RateLimiter is an interceptor delaying calls so they stay within client-side token buckets.
+// It waits on the request context, so cancellation and deadlines end the wait. A single
+// RateLimiter can be shared by all handlers (e.g. with sotton.WithInterceptor) to limit
+// the whole SDK.
+//
+// Retries sent by NewRetryMiddleware run below the interceptor chain and skip the limiter,
+// so resends, those of a 429 included, are not rate-limited. To limit every attempt, retry
+// with a RetryInterceptor whose Transporter is the handler's own chain.
+type RateLimiter struct {
+	global     *bucket
+	services   map[string]*bucket
+	operations map[string]*bucket
+	adaptive   *AdaptiveRateLimit
+	onWait     func(req *http.Request, wait time.Duration)
+}
+
+func NewRateLimiter(opts RateLimiterOptions) *RateLimiter {
+	l := &RateLimiter{
+		global:     newBucket(opts.Global),
+		services:   map[string]*bucket{},
+		operations: map[string]*bucket{},
+		onWait:     opts.OnWait,
+	}
+	for service, limit := range opts.Services {
+		if b := newBucket(limit); b != nil {
+			l.services[service] = b
+		}
+	}
+	for operation, limit := range opts.Operations {
+		if b := newBucket(limit); b != nil {
+			l.operations[operation] = b
+		}
+	}
+
+	if opts.Adaptive != nil {
+		adaptive := *opts.Adaptive
+		if adaptive.DecreaseFactor <= 0 || adaptive.DecreaseFactor >= 1 {
+			adaptive.DecreaseFactor = 0.5
+		}
+		if adaptive.MinFraction <= 0 || adaptive.MinFraction > 1 {
+			adaptive.MinFraction = 0.1
+		}
+		if adaptive.RecoveryInterval <= 0 {
+			adaptive.RecoveryInterval = 10 * time.Second
+		}
+		l.adaptive = &adaptive
+	}
+	return l
+}
+
+func (l *RateLimiter) Name() string {
+	return RateLimiterName
+}
+
+// BeforeRequest waits for a token from every bucket of the call.
+func (l *RateLimiter) BeforeRequest(data InterceptorData) (InterceptorData, error) {
+	buckets := l.buckets(data.Request)
+	if len(buckets) == 0 {
+		return data, nil
+	}
+	start := time.Now()
+	if err := l.wait(data.Ctx, buckets, start); err != nil {
+		return data, fmt.Errorf("waiting for rate limiter: %w", err)
+	}
+	if l.onWait != nil {
+		l.onWait(data.Request, time.Since(start))
+	}
+	return data, nil
+}
+
+// wait reserves a token from every bucket at once and waits for the last of them, so no
+// token is held while blocked on another bucket. When ctx ends first, or its deadline is
+// too close to wait, the reservations are cancelled and their tokens given back.
+func (l *RateLimiter) wait(ctx context.Context, buckets []*bucket, now time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	reservations := make([]*rate.Reservation, 0, len(buckets))
+	cancel := func() {
+		// cancelled as of the reservation time: the rate package gives nothing back for
+		// reservations already due when cancelled, such as the immediate ones
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+	var delay time.Duration
+	for _, b := range buckets {
+		b.recover(l.adaptive, now)
+		r := b.limiter.ReserveN(now, 1)
+		if !r.OK() {
+			cancel()
+			return fmt.Errorf("bucket of burst %d cannot grant a token", b.limiter.Burst())
+		}
+		reservations = append(reservations, r)
+		delay = max(delay, r.DelayFrom(now))
+	}
+	if delay == 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(delay)) {
+		cancel()
+		return fmt.Errorf("wait of %s would exceed the deadline: %w", delay, context.DeadlineExceeded)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		return ctx.Err()
+	}
+}
+
+// AfterResponse lowers the rates of the call's buckets when adaptation is on and the server
+// answered 429 or reported fewer requests left than the buckets allow.
+func (l *RateLimiter) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	if l.adaptive == nil || data.Response == nil {
+		return data, nil
+	}
+
+	now := time.Now()
+	remaining, reset, reported := serverRateLimit(data.Response, now)
+	for _, b := range l.buckets(data.Request) {
+		switch {
+		case data.Response.StatusCode == http.StatusTooManyRequests, reported && remaining == 0:
+			b.decrease(b.limiter.Limit()*rate.Limit(l.adaptive.DecreaseFactor), l.adaptive, now)
+		case reported && reset > 0:
+			b.decrease(rate.Limit(float64(remaining)/reset.Seconds()), l.adaptive, now)
+		}
+	}
+	return data, nil
+}
+
+// buckets returns the buckets applying to req, from the widest to the narrowest.
+func (l *RateLimiter) buckets(req *http.Request) []*bucket {
+	var buckets []*bucket
+	if l.global != nil {
+		buckets = append(buckets, l.global)
+	}
+	operation, ok := OperationFromContext(req.Context())
+	if !ok {
+		return buckets
+	}
+	if b, ok := l.services[operation.Service]; ok {
+		buckets = append(buckets, b)
+	}
+	if b, ok := l.operations[operation.String()]; ok {
+		buckets = append(buckets, b)
+	}
+	return buckets
+}
+
+// bucket is a token bucket whose rate can be lowered and recovered.
+type bucket struct {
+	limiter    *rate.Limiter
+	configured rate.Limit
+
+	mu        sync.Mutex
+	decreased time.Time // zero while the rate is the configured one
+}
+
+func newBucket(limit RateLimit) *bucket {
+	if limit.Rate <= 0 {
+		return nil
+	}
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = int(math.Max(1, limit.Rate))
+	}
+	return &bucket{
+		limiter:    rate.NewLimiter(rate.Limit(limit.Rate), burst),
+		configured: rate.Limit(limit.Rate),
+	}
+}
+
+// decrease lowers the rate to limit, but not below the adaptive floor.
+func (b *bucket) decrease(limit rate.Limit, adaptive *AdaptiveRateLimit, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if floor := b.configured * rate.Limit(adaptive.MinFraction); limit < floor {
+		limit = floor
+	}
+	if limit < b.limiter.Limit() {
+		b.limiter.SetLimitAt(now, limit)
+		b.decreased = now
+	}
+}
+
+// recover doubles a lowered rate, up to the configured one, once per RecoveryInterval
+// without a decrease.
+func (b *bucket) recover(adaptive *AdaptiveRateLimit, now time.Time) {
+	if adaptive == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.decreased.IsZero() || now.Sub(b.decreased) < adaptive.RecoveryInterval {
+		return
+	}
+	limit := b.limiter.Limit() * 2
+	if limit >= b.configured {
+		limit = b.configured
+		b.decreased = time.Time{}
+	} else {
+		b.decreased = now
+	}
+	b.limiter.SetLimitAt(now, limit)
+}
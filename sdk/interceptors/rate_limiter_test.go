@@ -0,0 +1,58 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterGivesBackTokensOfFailedWait(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		ctx  func() (context.Context, context.CancelFunc)
+		want error
+	}{
+		{
+			name: "deadline too close",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Second)
+			},
+			want: context.DeadlineExceeded,
+		},
+		{
+			name: "cancelled",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(10*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			want: context.Canceled,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := NewRateLimiter(RateLimiterOptions{
+				Global:   RateLimit{Rate: 0.001, Burst: 1},
+				Services: map[string]RateLimit{"iam_v1": {Rate: 0.001, Burst: 1}},
+			})
+			limiter.services["iam_v1"].limiter.Allow() // drain the service bucket
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+			ctx = context.WithValue(ctx, operationKey{}, Operation{Service: "iam_v1", Name: "GetUser"})
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = limiter.BeforeRequest(InterceptorData{Ctx: ctx, Request: req})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if tokens := limiter.global.limiter.Tokens(); tokens < 0.99 {
+				t.Errorf("global bucket has %.2f tokens after the failed wait, want 1", tokens)
+			}
+		})
+	}
+}
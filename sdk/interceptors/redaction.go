@@ -0,0 +1,176 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+)
+
+// RedactionOptions configures how loggers hide secrets. Redaction is on by default: the
+// values of constants.SensitiveHeaders, and the JSON fields of constants.SensitiveFields
+// and of the called operation's SensitiveFields, are replaced with constants.RedactedValue.
+type RedactionOptions struct {
+	// Disabled logs headers and bodies as they are.
+	Disabled bool
+	// Headers are further headers whose values are redacted.
+	Headers []string
+	// Fields are further JSON field names redacted wherever they appear in a body.
+	Fields []string
+	// Paths are JSON paths redacted from bodies, with dot-separated keys and "*" matching
+	// any key or array element, e.g. "credentials.*.value" or "items.*.api_key".
+	Paths []string
+}
+
+// Redactor hides secrets from logged headers and bodies.
+type Redactor struct {
+	disabled bool
+	headers  map[string]bool
+	fields   map[string]bool
+	paths    [][]string
+}
+
+func NewRedactor(opts RedactionOptions) *Redactor {
+	r := &Redactor{
+		disabled: opts.Disabled,
+		headers:  map[string]bool{},
+		fields:   map[string]bool{},
+	}
+	for _, header := range append(append([]string{}, constants.SensitiveHeaders...), opts.Headers...) {
+		r.headers[strings.ToLower(header)] = true
+	}
+	for _, field := range append(append([]string{}, constants.SensitiveFields...), opts.Fields...) {
+		r.fields[strings.ToLower(field)] = true
+	}
+	for _, path := range opts.Paths {
+		if path != "" {
+			r.paths = append(r.paths, strings.Split(path, "."))
+		}
+	}
+	return r
+}
+
+// Header returns the value of header name as it should be logged.
+func (r *Redactor) Header(name, value string) string {
+	if r.disabled || !r.headers[strings.ToLower(name)] {
+		return value
+	}
+	return constants.RedactedValue
+}
+
+// Body returns body as it should be logged. JSON bodies keep their shape with the sensitive
+// fields redacted, form bodies get their sensitive keys redacted, and other bodies, which
+// cannot be inspected, are replaced with a note of their size. The operation of the call,
+// if known, is read from ctx.
+func (r *Redactor) Body(ctx context.Context, body []byte, contentType string) string {
+	if r.disabled || len(body) == 0 {
+		return string(body)
+	}
+
+	fields := r.fields
+	if operation, ok := OperationFromContext(ctx); ok && len(operation.SensitiveFields) > 0 {
+		fields = make(map[string]bool, len(r.fields)+len(operation.SensitiveFields))
+		for field := range r.fields {
+			fields[field] = true
+		}
+		for _, field := range operation.SensitiveFields {
+			fields[strings.ToLower(field)] = true
+		}
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"),
+		mediaType == "" && json.Valid(body):
+		if redacted, err := r.redactJSON(body, fields); err == nil {
+			return redacted
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for key, list := range values {
+				if fields[strings.ToLower(key)] {
+					for i := range list {
+						list[i] = constants.RedactedValue
+					}
+				}
+			}
+			return values.Encode()
+		}
+	}
+	return fmt.Sprintf("[REDACTED non-JSON body: %d bytes]", len(body))
+}
+
+func (r *Redactor) redactJSON(body []byte, fields map[string]bool) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	value = redactFields(value, fields)
+	for _, path := range r.paths {
+		value = redactPath(value, path)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// redactFields replaces the values of the keys in fields, at any depth.
+func redactFields(value any, fields map[string]bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if fields[strings.ToLower(key)] && field != nil {
+				v[key] = constants.RedactedValue
+			} else {
+				v[key] = redactFields(field, fields)
+			}
+		}
+	case []any:
+		for i, element := range v {
+			v[i] = redactFields(element, fields)
+		}
+	}
+	return value
+}
+
+// redactPath replaces the values at path, whose "*" segments match any key or element.
+func redactPath(value any, path []string) any {
+	if len(path) == 0 {
+		if value == nil {
+			return nil
+		}
+		return constants.RedactedValue
+	}
+
+	segment := path[0]
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if segment == "*" || segment == key {
+				v[key] = redactPath(field, path[1:])
+			}
+		}
+	case []any:
+		index, err := strconv.Atoi(segment)
+		for i, element := range v {
+			if segment == "*" || (err == nil && index == i) {
+				v[i] = redactPath(element, path[1:])
+			}
+		}
+	}
+	return value
+}
@@ -0,0 +1,287 @@
+package interceptors
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
+)
+
+type ResponseCacheOptions struct {
+	// DefaultTTL is how long GET responses stay fresh when their operation has no entry in
+	// TTLs. Zero caches only the operations listed in TTLs.
+	DefaultTTL time.Duration
+	// TTLs sets the TTL of operations, keyed by Operation.String() (e.g. "iam_v1.ListRoles").
+	// A TTL of zero or less turns caching off for the operation.
+	TTLs map[string]time.Duration
+	// MaxEntries bounds the number of cached responses; the least recently used go first.
+	// Default 1000.
+	MaxEntries int
+	// MaxBodySize is the largest body cached, in bytes. Default 1 MiB.
+	MaxBodySize int64
+}
+
+// ResponseCache is an interceptor answering GET calls from a cache while their response is
+// fresh. Stale responses with an ETag are revalidated with If-None-Match, and a 304 renews
+// them. Entries are keyed by the Authorization header of the call as well as its URL, so
+// callers with different credentials never share them: add the cache after the
+// Authenticator, and after the interceptors that must see every call, such as metrics and
+// tracing, since interceptors after the cache do not see hits. A successful call of any
+// other method invalidates the entries of the resource collection it touched, within its
+// workspace (see cacheScope), for every credential. callopts.NoCache bypasses the cache.
+type ResponseCache struct {
+	opts ResponseCacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // of *cacheEntry, most recently used first
+}
+
+type cacheEntry struct {
+	key     string
+	path    string
+	scope   string
+	status  int
+	header  http.Header
+	body    []byte
+	etag    string
+	expires time.Time
+}
+
+func NewResponseCache(opts ResponseCacheOptions) *ResponseCache {
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = 1000
+	}
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = 1 << 20
+	}
+	return &ResponseCache{
+		opts:    opts,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+func (c *ResponseCache) Name() string {
+	return ResponseCacheName
+}
+
+type cacheCallKey struct {
+	cache *ResponseCache
+}
+
+// cacheCall is what BeforeRequest keeps for AfterResponse.
+type cacheCall struct {
+	key   string
+	ttl   time.Duration
+	stale *cacheEntry // being revalidated
+}
+
+// BeforeRequest answers fresh cached responses and asks the server to revalidate stale ones.
+func (c *ResponseCache) BeforeRequest(data InterceptorData) (InterceptorData, error) {
+	if data.Request.Method != http.MethodGet || callopts.FromContext(data.Ctx).NoCache {
+		return data, nil
+	}
+	ttl := c.ttl(data.Request)
+	if ttl <= 0 {
+		return data, nil
+	}
+
+	call := &cacheCall{key: cacheKey(data.Request), ttl: ttl}
+	if entry, ok := c.get(call.key); ok {
+		if time.Now().Before(entry.expires) {
+			data.Response = entry.response(data.Request)
+			return data, nil
+		}
+		if entry.etag != "" && data.Request.Header.Get("If-None-Match") == "" {
+			data.Request.Header.Set("If-None-Match", entry.etag)
+			call.stale = entry
+		}
+	}
+
+	data.Ctx = context.WithValue(data.Ctx, cacheCallKey{c}, call)
+	data.Request = data.Request.WithContext(data.Ctx)
+	return data, nil
+}
+
+// AfterResponse stores successful GET responses, renews revalidated ones and invalidates
+// the entries made stale by mutations.
+func (c *ResponseCache) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	if data.Error != nil || data.Response == nil || !wasSent(data.Ctx) {
+		return data, nil
+	}
+
+	switch data.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		if data.Response.StatusCode < 300 {
+			c.invalidateScope(cacheScope(data.Request.URL.Path))
+		}
+		return data, nil
+	}
+
+	call, ok := data.Ctx.Value(cacheCallKey{c}).(*cacheCall)
+	if !ok {
+		return data, nil
+	}
+	switch {
+	case data.Response.StatusCode == http.StatusNotModified && call.stale != nil:
+		discardBody(data.Response)
+		renewed := *call.stale
+		renewed.expires = time.Now().Add(call.ttl)
+		c.put(&renewed)
+		data.Response = renewed.response(data.Request)
+	case data.Response.StatusCode == http.StatusOK && !noStore(data.Response):
+		if data.Response.ContentLength > c.opts.MaxBodySize {
+			return data, nil
+		}
+		body, err := io.ReadAll(io.LimitReader(data.Response.Body, c.opts.MaxBodySize+1))
+		if err != nil {
+			data.Response.Body.Close()
+			data.Error = err
+			return data, nil
+		}
+		if int64(len(body)) > c.opts.MaxBodySize {
+			// too large to cache: hand the caller what was read followed by the rest
+			data.Response.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), data.Response.Body), data.Response.Body}
+			return data, nil
+		}
+		data.Response.Body.Close()
+		data.Response.Body = io.NopCloser(bytes.NewReader(body))
+		c.put(&cacheEntry{
+			key:     call.key,
+			path:    data.Request.URL.Path,
+			scope:   cacheScope(data.Request.URL.Path),
+			status:  data.Response.StatusCode,
+			header:  data.Response.Header.Clone(),
+			body:    body,
+			etag:    data.Response.Header.Get("ETag"),
+			expires: time.Now().Add(call.ttl),
+		})
+	}
+	return data, nil
+}
+
+// Invalidate drops the cached responses whose path starts with pathPrefix, for every credential.
+func (c *ResponseCache) Invalidate(pathPrefix string) {
+	c.remove(func(entry *cacheEntry) bool {
+		return strings.HasPrefix(entry.path, pathPrefix)
+	})
+}
+
+// Clear drops every cached response.
+func (c *ResponseCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]*list.Element{}
+	c.lru.Init()
+}
+
+func (c *ResponseCache) ttl(req *http.Request) time.Duration {
+	if operation, ok := OperationFromContext(req.Context()); ok {
+		if ttl, ok := c.opts.TTLs[operation.String()]; ok {
+			return ttl
+		}
+	}
+	return c.opts.DefaultTTL
+}
+
+func (c *ResponseCache) get(key string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	return element.Value.(*cacheEntry), true
+}
+
+func (c *ResponseCache) put(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[entry.key]; ok {
+		element.Value = entry
+		c.lru.MoveToFront(element)
+		return
+	}
+	c.entries[entry.key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.opts.MaxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *ResponseCache) invalidateScope(scope string) {
+	c.remove(func(entry *cacheEntry) bool {
+		return strings.HasPrefix(entry.scope, scope)
+	})
+}
+
+func (c *ResponseCache) remove(match func(*cacheEntry) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for element := c.lru.Front(); element != nil; {
+		next := element.Next()
+		if entry := element.Value.(*cacheEntry); match(entry) {
+			c.lru.Remove(element)
+			delete(c.entries, entry.key)
+		}
+		element = next
+	}
+}
+
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// cacheKey identifies a GET by its credential and URL. The credential is hashed, so the
+// cache holds no secrets.
+func cacheKey(req *http.Request) string {
+	credential := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return hex.EncodeToString(credential[:]) + " " + req.URL.String()
+}
+
+// cacheScope returns what a mutation of path makes stale: within a workspace, the resource
+// collection after the workspace ID, e.g. "workspace/<uuid>/group/" for the groups of a
+// workspace and every group in it; outside workspaces, the parent of the last path segment.
+// A mutation invalidates the entries whose scope starts with its own.
+func cacheScope(path string) string {
+	segments := splitPath(path)
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "workspace" {
+			scope := "workspace/" + segments[i+1] + "/"
+			if i+2 < len(segments) {
+				scope += segments[i+2] + "/"
+			}
+			return scope
+		}
+	}
+	return "/" + strings.Join(segments[:len(segments)-1], "/") + "/"
+}
+
+func noStore(response *http.Response) bool {
+	return strings.Contains(strings.ToLower(response.Header.Get("Cache-Control")), "no-store")
+}
@@ -1,13 +1,17 @@
 package interceptors
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
 	"time"
 
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
 	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
-
-	"github.com/patrickmn/go-cache"
 )
 
 type Transporter interface {
@@ -18,100 +22,226 @@ type BackoffTimer interface {
 	TimeToWait(iteration int) time.Duration
 }
 
+// ChainedBackoffTimer is implemented by strategies whose next wait depends on the previous one,
+// such as decorrelated jitter. The retry loop passes the wait it used before the last attempt.
+type ChainedBackoffTimer interface {
+	BackoffTimer
+	TimeToWaitAfter(iteration int, previous time.Duration) time.Duration
+}
+
 type RetryDecider interface {
 	// ShouldRetry determines whether a failed HTTP request should be retried.
 	// It receives the HTTP response (if any), the error (if any), and retry metadata.
 	// Returns:
 	//   - bool: true if the request should be retried, false otherwise
-	//   - error: non-nil if an error occurred during the decision process. returning error stops the whole interceptorsChain.
+	//   - error: non-nil if an error occurred during the decision process. It ends the call with that error.
 	ShouldRetry(*http.Response, error, RetryInternalData) (bool, error)
 }
 
+// RetryWaiter is implemented by deciders that adjust the wait picked by the BackoffTimer,
+// e.g. to honor Retry-After. Returning an error stops retrying, like ShouldRetry.
+type RetryWaiter interface {
+	RetryWait(response *http.Response, backoff time.Duration, retryData RetryInternalData) (time.Duration, error)
+}
+
 type RetryInternalData struct {
+	// RetryCount is the number of attempts made so far, starting at 1 after the first failure,
+	// so RetryCount-1 retries have been sent. Deciders stop once it exceeds their maximum.
 	RetryCount int
+	// MaxRetries is the per-call maximum set with callopts.MaxRetries, zero when unset.
+	MaxRetries int
+	// Elapsed is the time spent retrying so far, measured from the first failure.
+	Elapsed time.Duration
 }
 
+// RetryInterceptor resends failed requests through its Transporter. The attempt count of a
+// call lives in the request context, so the interceptor itself holds no per-request state.
 type RetryInterceptor struct {
-	cache           *cache.Cache
-	transporter     Transporter
-	backoffStrategy BackoffTimer
-	retryDecider    RetryDecider
+	transporter Transporter
+	retryer
 }
 
 func NewRetryInterceptor(transporter Transporter, backoffStrategy BackoffTimer, retryDecider RetryDecider) *RetryInterceptor {
 	return &RetryInterceptor{
-		cache:           cache.New(time.Minute, time.Minute*15),
-		transporter:     transporter,
-		backoffStrategy: backoffStrategy,
-		retryDecider:    retryDecider,
+		transporter: transporter,
+		retryer: retryer{
+			backoffStrategy: backoffStrategy,
+			retryDecider:    retryDecider,
+		},
+	}
+}
+
+// NewRetryMiddleware retries like RetryInterceptor but resends through the rest of the
+// middleware stack instead of a separate Transporter, so the interceptor chain runs once per call.
+func NewRetryMiddleware(backoffStrategy BackoffTimer, retryDecider RetryDecider) Middleware {
+	r := retryer{backoffStrategy: backoffStrategy, retryDecider: retryDecider}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if isRetryAttempt(req.Context()) || callopts.FromContext(req.Context()).NoRetry {
+				return next.RoundTrip(req)
+			}
+			if err := makeReplayable(req); err != nil {
+				return nil, err
+			}
+			resp, err := next.RoundTrip(req)
+			return r.run(req, resp, err, next.RoundTrip)
+		})
 	}
 }
 
+// Close is a no-op kept for compatibility; the interceptor has no background work.
+func (e *RetryInterceptor) Close() error {
+	return nil
+}
+
+func (e *RetryInterceptor) Name() string {
+	return RetryName
+}
+
+// BeforeRequest buffers the request body, unless it can already be rebuilt with GetBody,
+// so that every attempt sends the whole body.
 func (e *RetryInterceptor) BeforeRequest(data InterceptorData) (InterceptorData, error) {
-	if data.Error != nil {
+	if isRetryAttempt(data.Ctx) || callopts.FromContext(data.Ctx).NoRetry {
+		return data, nil
+	}
+	if err := makeReplayable(data.Request); err != nil {
+		return data, err
+	}
+	if data.InitialRequest != nil && data.InitialRequest.GetBody == nil {
+		data.InitialRequest.GetBody = data.Request.GetBody
+	}
+	return data, nil
+}
+
+// AfterResponse resends InitialRequest until the decider gives up. Attempts sent by the
+// interceptor are marked in their context, so a RetryInterceptor in the Transporter's own
+// chain lets them through instead of starting a second retry loop. Calls answered or
+// rejected by a later BeforeRequest were never sent, so they are not retried.
+func (e *RetryInterceptor) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	if isRetryAttempt(data.Ctx) || callopts.FromContext(data.Ctx).NoRetry || !wasSent(data.Ctx) {
+		return data, nil
+	}
+
+	request := data.InitialRequest
+	if request == nil {
+		request = data.Request
+	}
+	resp, err := e.run(request, data.Response, data.Error, func(req *http.Request) (*http.Response, error) {
+		return e.transporter.RoundTripWithID(req, data.ID)
+	})
+	// the outcome stays in data, so the AfterResponse of later interceptors still sees it
+	data.Response, data.Error = resp, err
+	return data, nil
+}
 
-		d := e.getRetryInternalData(data)
-		if shouldRetry, err := e.retryDecider.ShouldRetry(data.Response, data.Error, d); !shouldRetry {
-			if err != nil {
-				panic(err)
+// retryer is the retry loop shared by RetryInterceptor and NewRetryMiddleware.
+type retryer struct {
+	backoffStrategy BackoffTimer
+	retryDecider    RetryDecider
+}
+
+type retryAttemptKey struct{}
+
+func isRetryAttempt(ctx context.Context) bool {
+	return ctx != nil && ctx.Value(retryAttemptKey{}) != nil
+}
+
+// run asks the decider about the outcome of the first attempt (resp, err) and keeps
+// resending req until it gives up or the request context is done.
+func (r retryer) run(req *http.Request, resp *http.Response, err error, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	ctx := req.Context()
+	attemptCtx := context.WithValue(ctx, retryAttemptKey{}, true)
+	maxRetries := callopts.FromContext(ctx).MaxRetries
+	start := time.Now()
+	var wait time.Duration
+
+	for attempt := 1; ; attempt++ {
+		retryData := RetryInternalData{RetryCount: attempt, MaxRetries: maxRetries, Elapsed: time.Since(start)}
+		shouldRetry, decideErr := r.retryDecider.ShouldRetry(resp, err, retryData)
+		if decideErr != nil {
+			return resp, decideErr
+		}
+		if !shouldRetry || !IsRetrySafe(req) {
+			return resp, err
+		}
+
+		wait = r.timeToWait(attempt, wait)
+		if waiter, ok := r.retryDecider.(RetryWaiter); ok {
+			if wait, decideErr = waiter.RetryWait(resp, wait, retryData); decideErr != nil {
+				return resp, decideErr
 			}
-			return data, data.Error
 		}
 
-		time.Sleep(e.backoffStrategy.TimeToWait(d.RetryCount))
+		next, cloneErr := cloneForRetry(req, attemptCtx)
+		if cloneErr != nil {
+			return resp, errors.Join(err, cloneErr)
+		}
+		discardBody(resp)
 
-		response, err := e.transporter.RoundTripWithID(data.Request, data.ID)
-		if err != nil || response.StatusCode >= 400 {
-			if err != nil {
-				data.Error = err
-			}
-			return e.BeforeRequest(data)
+		if waitErr := sleepContext(ctx, wait); waitErr != nil {
+			return nil, waitErr
 		}
-		data.Error = nil
-		data.Response = response
+		resp, err = send(next)
 	}
-	return data, nil
 }
 
-func (e *RetryInterceptor) AfterResponse(data InterceptorData) (InterceptorData, error) {
-
-	d := e.getRetryInternalData(data)
-	shouldRetry, err := e.retryDecider.ShouldRetry(data.Response, data.Error, d)
+func (r retryer) timeToWait(attempt int, previous time.Duration) time.Duration {
+	if chained, ok := r.backoffStrategy.(ChainedBackoffTimer); ok && attempt > 1 {
+		return chained.TimeToWaitAfter(attempt, previous)
+	}
+	return r.backoffStrategy.TimeToWait(attempt)
+}
 
+// makeReplayable makes sure req.GetBody can rebuild the body, reading it into memory if needed.
+func makeReplayable(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
 	if err != nil {
-		return data, err
+		return fmt.Errorf("buffering request body for retries: %w", err)
 	}
-	if !shouldRetry {
-		return data, data.Error
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
 	}
+	req.Body, _ = req.GetBody()
+	return nil
+}
 
-	time.Sleep(e.backoffStrategy.TimeToWait(d.RetryCount))
-
-	response, err := e.transporter.RoundTripWithID(data.InitialRequest, data.ID)
-	data.Response = response
-	if err != nil || (response != nil && response.StatusCode >= 400) {
-		if err != nil {
-			data.Error = err
-		}
-		return e.AfterResponse(data)
+// cloneForRetry copies req for another attempt with a fresh body.
+func cloneForRetry(req *http.Request, ctx context.Context) (*http.Request, error) {
+	next := req.Clone(ctx)
+	if req.Body == nil || req.Body == http.NoBody {
+		return next, nil
 	}
-	data.Error = nil
-
-	return data, nil
+	if req.GetBody == nil {
+		return nil, errors.New("request body cannot be replayed")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	next.Body = body
+	return next, nil
 }
 
-func (e *RetryInterceptor) getRetryInternalData(data InterceptorData) RetryInternalData {
-	internalData, found := e.cache.Get(data.ID)
-	var d RetryInternalData
-	if found {
-		d = internalData.(RetryInternalData)
-		d.RetryCount++
-		e.cache.Set(data.ID, d, cache.DefaultExpiration)
-	} else {
-		d = RetryInternalData{RetryCount: 1}
-		e.cache.Set(data.ID, d, cache.DefaultExpiration)
+// sleepContext waits for d, returning early with the cause of ctx when it is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		if ctx.Err() != nil {
+			return context.Cause(ctx)
+		}
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case <-timer.C:
+		return nil
 	}
-	return d
 }
 
 /////////////////////////////////////////
@@ -154,8 +284,9 @@ type BackoffStrategyLinier struct {
 	baseDuration time.Duration
 }
 
-func NewRetryInterceptor_BackoffStrategyLinier(baseDuration time.Duration) BackoffStrategyExpnential {
-	return BackoffStrategyExpnential{
+// NewRetryInterceptor_BackoffStrategyLinier waits baseDuration between all attempts.
+func NewRetryInterceptor_BackoffStrategyLinier(baseDuration time.Duration) BackoffStrategyLinier {
+	return BackoffStrategyLinier{
 		baseDuration: baseDuration,
 	}
 }
@@ -164,6 +295,76 @@ func (b BackoffStrategyLinier) TimeToWait(iteration int) time.Duration {
 	return b.baseDuration
 }
 
+// BackoffStrategyFullJitter waits a random time between zero and the exponential backoff,
+// which spreads out clients that failed at the same moment.
+type BackoffStrategyFullJitter struct {
+	baseDuration time.Duration
+	maxBackoff   time.Duration
+}
+
+func NewRetryInterceptor_FullJitterBackoff(baseDuration, maxBackoff time.Duration) BackoffStrategyFullJitter {
+	return BackoffStrategyFullJitter{
+		baseDuration: baseDuration,
+		maxBackoff:   maxBackoff,
+	}
+}
+
+func (b BackoffStrategyFullJitter) TimeToWait(iteration int) time.Duration {
+	return randomDuration(0, cappedExponential(b.baseDuration, iteration, b.maxBackoff))
+}
+
+// BackoffStrategyDecorrelatedJitter waits a random time between baseDuration and three times
+// the previous wait, capped at maxBackoff.
+type BackoffStrategyDecorrelatedJitter struct {
+	baseDuration time.Duration
+	maxBackoff   time.Duration
+}
+
+func NewRetryInterceptor_DecorrelatedJitterBackoff(baseDuration, maxBackoff time.Duration) BackoffStrategyDecorrelatedJitter {
+	return BackoffStrategyDecorrelatedJitter{
+		baseDuration: baseDuration,
+		maxBackoff:   maxBackoff,
+	}
+}
+
+func (b BackoffStrategyDecorrelatedJitter) TimeToWait(iteration int) time.Duration {
+	return b.TimeToWaitAfter(iteration, b.baseDuration)
+}
+
+func (b BackoffStrategyDecorrelatedJitter) TimeToWaitAfter(iteration int, previous time.Duration) time.Duration {
+	if previous < b.baseDuration {
+		previous = b.baseDuration
+	}
+	upper := previous * 3
+	if upper < previous || upper > b.maxBackoff {
+		upper = b.maxBackoff
+	}
+	if upper < b.baseDuration {
+		return upper
+	}
+	return randomDuration(b.baseDuration, upper)
+}
+
+// cappedExponential returns base * 2^iteration, at most maxBackoff.
+func cappedExponential(base time.Duration, iteration int, maxBackoff time.Duration) time.Duration {
+	backoff := base
+	for i := 0; i < iteration && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff || backoff < 0 {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// randomDuration returns a random duration in [low, high].
+func randomDuration(low, high time.Duration) time.Duration {
+	if high <= low {
+		return low
+	}
+	return low + time.Duration(rand.Int63n(int64(high-low)+1))
+}
+
 /////////////////////////////////////
 
 // RetryDeciderAll retries all requests that fail
@@ -178,20 +379,115 @@ func NewRetryInterceptor_RetryDeciderAll(maxRetries int) RetryDeciderAll {
 }
 
 func (r RetryDeciderAll) ShouldRetry(response *http.Response, err error, retryData RetryInternalData) (bool, error) {
+	maxRetries := r.maxRetries
+	if retryData.MaxRetries > 0 {
+		maxRetries = retryData.MaxRetries
+	}
+
+	// a successful attempt ends the call, even when it is the last one allowed
+	if err == nil && (response == nil || response.StatusCode < 400) {
+		return false, nil
+	}
 
-	if retryData.RetryCount >= r.maxRetries {
+	if retryData.RetryCount > maxRetries {
 		// Return the actual error that caused the failure, not a generic "max retries exceeded" error
 		if err != nil {
 			return false, err
 		}
-		// If no error but we have a bad response, return a generic error
+		// If no error but we have a bad response, return its *APIError with ErrMaxRetriesExceeded
 		// (though this shouldn't happen if TreatAsErrorInterceptor runs before RetryInterceptor)
-		return false, constants.ErrMaxRetriesExceeded
+		return false, retriesExhausted(response)
+	}
+	return true, nil
+}
+
+// RetryDeciderStandard retries network errors and the statuses worth retrying (408, 429 and
+// 5xx), waits at least as long as the server asks with Retry-After or the rate-limit headers,
+// and stops once maxElapsed has been spent retrying. Client errors such as 400, 401 and 403
+// are returned at once.
+type RetryDeciderStandard struct {
+	maxRetries int
+	maxElapsed time.Duration
+}
+
+// NewRetryInterceptor_RetryDeciderStandard returns the standard decider. A zero maxElapsed
+// leaves the retry time unbounded, apart from the request context.
+func NewRetryInterceptor_RetryDeciderStandard(maxRetries int, maxElapsed time.Duration) RetryDeciderStandard {
+	return RetryDeciderStandard{
+		maxRetries: maxRetries,
+		maxElapsed: maxElapsed,
 	}
+}
 
-	if err != nil || (response != nil && response.StatusCode >= 400) {
-		return true, nil
+func (r RetryDeciderStandard) ShouldRetry(response *http.Response, err error, retryData RetryInternalData) (bool, error) {
+	if !IsRetryable(response, err) {
+		return false, nil
 	}
 
-	return false, nil
+	maxRetries := r.maxRetries
+	if retryData.MaxRetries > 0 {
+		maxRetries = retryData.MaxRetries
+	}
+	if retryData.RetryCount > maxRetries {
+		if err != nil {
+			return false, err
+		}
+		return false, retriesExhausted(response)
+	}
+	return true, nil
+}
+
+// RetryWait waits for the longer of the backoff and the server's hint, and gives up when the
+// wait would go past the retry time budget.
+func (r RetryDeciderStandard) RetryWait(response *http.Response, backoff time.Duration, retryData RetryInternalData) (time.Duration, error) {
+	wait := backoff
+	if hint, ok := ServerRetryDelay(response, time.Now()); ok && hint > wait {
+		wait = hint
+	}
+	if r.maxElapsed > 0 && retryData.Elapsed+wait > r.maxElapsed {
+		return 0, fmt.Errorf("%w: next attempt in %s would exceed the retry budget of %s",
+			retriesExhausted(response), wait, r.maxElapsed)
+	}
+	return wait, nil
+}
+
+// retriesExhausted is the error of a call given up on after response: ErrMaxRetriesExceeded,
+// wrapping the *APIError of response when it failed.
+func retriesExhausted(response *http.Response) error {
+	if apiErr, _ := ReadAPIError(response); apiErr != nil {
+		return fmt.Errorf("%w: %w", constants.ErrMaxRetriesExceeded, apiErr)
+	}
+	return constants.ErrMaxRetriesExceeded
+}
+
+// IsRetryable reports whether a call that ended with response and err may succeed when sent
+// again: network errors, per-attempt timeouts, 408 Request Timeout, 429 Too Many Requests and
+// 5xx responses. Cancellation and deadlines of the caller's context are never retryable, nor
+// are calls rejected by an open circuit breaker.
+func IsRetryable(response *http.Response, err error) bool {
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return timeoutErr.Limit == TimeoutLimitAttempt
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, constants.ErrCircuitBreakerOpen) {
+		return false
+	}
+	// a resend through a chain holding TreatAsError reports error statuses as an *APIError
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusRequestTimeout ||
+			apiErr.StatusCode == http.StatusTooManyRequests ||
+			apiErr.StatusCode >= 500
+	}
+	if response == nil {
+		return err != nil
+	}
+	switch {
+	case response.StatusCode == http.StatusRequestTimeout,
+		response.StatusCode == http.StatusTooManyRequests,
+		response.StatusCode >= 500:
+		return true
+	}
+	return false
 }
@@ -0,0 +1,105 @@
+package interceptors
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ServerRetryDelay returns how long the server asked the client to wait before sending again,
+// read from Retry-After (seconds or HTTP-date), then RateLimit-Reset, then X-RateLimit-Reset.
+// The rate-limit headers only count on a 429 or when their Remaining counterpart is 0.
+// The delay is capped at maxServerRetryDelay.
+func ServerRetryDelay(response *http.Response, now time.Time) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+	if delay, ok := parseRetryAfter(response.Header.Get("Retry-After"), now); ok {
+		return delay, true
+	}
+
+	limited := response.StatusCode == http.StatusTooManyRequests
+	for _, prefix := range []string{"RateLimit-", "X-RateLimit-"} {
+		if !limited && strings.TrimSpace(response.Header.Get(prefix+"Remaining")) != "0" {
+			continue
+		}
+		if delay, ok := parseRateLimitReset(response.Header.Get(prefix+"Reset"), now); ok {
+			return delay, true
+		}
+	}
+	return 0, false
+}
+
+// parseRetryAfter parses the delay-seconds and HTTP-date forms of Retry-After.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return secondsDelay(float64(seconds)), true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return min(nonNegative(date.Sub(now)), maxServerRetryDelay), true
+}
+
+// maxServerRetryDelay caps the waits servers ask for, so a huge or bogus header cannot stall
+// a call for days or overflow time.Duration.
+const maxServerRetryDelay = time.Hour
+
+// secondsDelay converts a number of seconds to a duration of at most maxServerRetryDelay.
+func secondsDelay(seconds float64) time.Duration {
+	if seconds >= maxServerRetryDelay.Seconds() {
+		return maxServerRetryDelay
+	}
+	return nonNegative(time.Duration(seconds * float64(time.Second)))
+}
+
+// unixTimestampThreshold separates reset values sent as a number of seconds from those sent
+// as a Unix time, as X-RateLimit-Reset is used both ways.
+const unixTimestampThreshold = 1_000_000_000
+
+// parseRateLimitReset parses a reset header holding either seconds to wait or a Unix time.
+func parseRateLimitReset(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil || !(seconds >= 0) { // also rejects NaN
+		return 0, false
+	}
+	if seconds >= unixTimestampThreshold {
+		seconds -= float64(now.UnixNano()) / float64(time.Second)
+	}
+	return secondsDelay(seconds), true
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// serverRateLimit returns the requests left and the time until the quota resets, as sent in
+// RateLimit-Remaining/RateLimit-Reset or their X- counterparts.
+func serverRateLimit(response *http.Response, now time.Time) (remaining int, reset time.Duration, ok bool) {
+	for _, prefix := range []string{"RateLimit-", "X-RateLimit-"} {
+		remaining, err := strconv.Atoi(strings.TrimSpace(response.Header.Get(prefix + "Remaining")))
+		if err != nil || remaining < 0 {
+			continue
+		}
+		if reset, ok := parseRateLimitReset(response.Header.Get(prefix+"Reset"), now); ok {
+			return remaining, reset, true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,48 @@
+package interceptors
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryStopsOnClientErrorOfResend(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	// the retry resends through its own chain, where TreatAsError turns the 400 into an *APIError
+	transport := NewInterceptorTransport(http.DefaultTransport, nil)
+	transport.AddInterceptors(
+		NewRetryInterceptor(transport,
+			NewRetryInterceptor_ExponentialBackoff(time.Millisecond, time.Millisecond),
+			NewRetryInterceptor_RetryDeciderStandard(5, 0)),
+		NewTreatAsErrorInterceptor(NewTreatAsErrorInterceptor_ErrorDetectorAll()),
+	)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("err = %v, want an *APIError with status 400", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("server got %d requests, want 2", got)
+	}
+}
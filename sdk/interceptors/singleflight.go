@@ -0,0 +1,160 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
+)
+
+// NewSingleflightMiddleware merges concurrent identical GETs into one upstream request.
+// Requests are identical when they have the same URL, Authorization header and call
+// options, so callers with different credentials, headers, timeouts or retry settings
+// never share a response. Every caller gets its own copy of the response, body included,
+// and has the attempts of the shared request added to its own.
+//
+// The shared request runs detached from the callers' contexts: a caller that gives up
+// returns its own context error, and the shared request is cancelled only once every
+// caller waiting on it has gone. Its context keeps only the operation and call options
+// of the call, not the values of the first caller such as its trace span or request ID.
+// Add it before a retry middleware, so merged callers share the retries too.
+// callopts.NoCache opts a call out.
+func NewSingleflightMiddleware() Middleware {
+	g := &flightGroup{flights: map[string]*flight{}}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet || (req.Body != nil && req.Body != http.NoBody) ||
+				callopts.FromContext(req.Context()).NoCache {
+				return next.RoundTrip(req)
+			}
+			return g.do(req, next)
+		})
+	}
+}
+
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// flight is one shared upstream request.
+type flight struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int // guarded by flightGroup.mu
+
+	response *http.Response
+	body     []byte
+	err      error
+	attempts int
+}
+
+func (g *flightGroup) do(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	key := req.Method + " " + cacheKey(req) + " " + callOptionsKey(callopts.FromContext(req.Context()))
+
+	g.mu.Lock()
+	f, ok := g.flights[key]
+	if !ok {
+		ctx, cancel := context.WithCancel(detachedCallContext(req.Context()))
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.flights[key] = f
+		go g.run(key, f, next, req.WithContext(ctx))
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		g.leave(key, f)
+		if state, ok := req.Context().Value(callStateKey{}).(*callState); ok {
+			state.attempts.Add(int32(f.attempts))
+		}
+		if f.err != nil {
+			return nil, f.err
+		}
+		return f.copyResponse(req), nil
+	case <-req.Context().Done():
+		g.leave(key, f)
+		return nil, context.Cause(req.Context())
+	}
+}
+
+func (g *flightGroup) run(key string, f *flight, next http.RoundTripper, req *http.Request) {
+	response, err := next.RoundTrip(req)
+	if err == nil {
+		f.body, err = io.ReadAll(response.Body)
+		response.Body.Close()
+	}
+	f.response, f.err = response, err
+	f.attempts = AttemptsFromContext(req.Context())
+
+	g.mu.Lock()
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+	g.mu.Unlock()
+	f.cancel()
+	close(f.done)
+}
+
+// leave drops a caller from f, cancelling the request when nobody waits for it anymore.
+func (g *flightGroup) leave(key string, f *flight) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	f.waiters--
+	if f.waiters > 0 {
+		return
+	}
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+	f.cancel()
+}
+
+func (f *flight) copyResponse(req *http.Request) *http.Response {
+	response := *f.response
+	response.Header = f.response.Header.Clone()
+	response.Trailer = f.response.Trailer.Clone()
+	response.Body = io.NopCloser(bytes.NewReader(f.body))
+	response.ContentLength = int64(len(f.body))
+	response.Request = req
+	return &response
+}
+
+// detachedCallContext returns a context for a request shared by several calls. It keeps
+// the operation and call options of ctx, which the shared calls have in common, and a call
+// state of its own, but none of ctx's deadline, cancellation or other per-call values.
+func detachedCallContext(ctx context.Context) context.Context {
+	detached := context.WithValue(context.Background(), callStateKey{}, &callState{})
+	if operation, ok := OperationFromContext(ctx); ok {
+		detached = context.WithValue(detached, operationKey{}, operation)
+	}
+	if isRetryAttempt(ctx) {
+		detached = context.WithValue(detached, retryAttemptKey{}, true)
+	}
+	options := callopts.FromContext(ctx)
+	return callopts.WithCallOptions(detached, func(o *callopts.Options) { *o = options })
+}
+
+// callOptionsKey fingerprints the call options, so that only calls made with the same
+// options share a request.
+func callOptionsKey(options callopts.Options) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%t %d %s %t %t %q", options.NoRetry, options.MaxRetries, options.Timeout,
+		options.SkipLogging, options.AllowUnsafeRetry, options.IdempotencyKey)
+	names := make([]string, 0, len(options.Headers))
+	for name := range options.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%q", name, options.Headers[name])
+	}
+	return b.String()
+}
@@ -0,0 +1,141 @@
+package interceptors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
+)
+
+// attemptRecorder records the attempts of every call in its AfterResponse.
+type attemptRecorder struct {
+	mu       sync.Mutex
+	attempts []int
+}
+
+func (r *attemptRecorder) BeforeRequest(data InterceptorData) (InterceptorData, error) {
+	return data, nil
+}
+
+func (r *attemptRecorder) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attempts = append(r.attempts, AttemptsFromContext(data.Ctx))
+	return data, nil
+}
+
+func TestSingleflightMergesCallsWithSameOptions(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		headers  [2]string
+		upstream int
+	}{
+		{name: "same options", headers: [2]string{"1", "1"}, upstream: 1},
+		{name: "different options", headers: [2]string{"1", "2"}, upstream: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			arrived, release := make(chan string, 2), make(chan struct{})
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				arrived <- r.Header.Get("X-Tenant")
+				<-release
+				w.Write([]byte("ok"))
+			}))
+			defer srv.Close()
+			var releaseOnce sync.Once
+			unblock := func() { releaseOnce.Do(func() { close(release) }) }
+			defer unblock() // before srv.Close, which waits for the handlers
+
+			g := &flightGroup{flights: map[string]*flight{}}
+			recorder := &attemptRecorder{}
+			transport := NewInterceptorTransport(http.DefaultTransport, []Interceptor{recorder})
+			transport.AddMiddlewares(func(next http.RoundTripper) http.RoundTripper {
+				return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					return g.do(req, next)
+				})
+			})
+
+			var wg sync.WaitGroup
+			for _, header := range tt.headers {
+				wg.Add(1)
+				go func(header string) {
+					defer wg.Done()
+					ctx := callopts.WithCallOptions(context.Background(), callopts.Header("X-Tenant", header))
+					req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+					resp, err := transport.RoundTrip(req)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					resp.Body.Close()
+				}(header)
+			}
+
+			for i := 0; i < tt.upstream; i++ {
+				select {
+				case <-arrived:
+				case <-time.After(5 * time.Second):
+					t.Fatalf("server got %d requests, want %d", i, tt.upstream)
+				}
+			}
+			waitForWaiters(t, g, 2)
+			unblock()
+			wg.Wait()
+
+			if got := len(arrived); got != 0 {
+				t.Errorf("server got %d more requests, want %d in all", got, tt.upstream)
+			}
+			for i, attempts := range recorder.attempts {
+				if attempts != 1 {
+					t.Errorf("call %d counted %d attempts, want 1", i+1, attempts)
+				}
+			}
+		})
+	}
+}
+
+// waitForWaiters waits until n callers wait on the flights of g.
+func waitForWaiters(t *testing.T, g *flightGroup, n int) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		g.mu.Lock()
+		waiters := 0
+		for _, f := range g.flights {
+			waiters += f.waiters
+		}
+		g.mu.Unlock()
+		if waiters == n {
+			return
+		}
+	}
+	t.Fatalf("callers never joined the flights")
+}
+
+func TestDetachedCallContextDropsCallValues(t *testing.T) {
+	operation := Operation{Service: "iam_v1", Name: "GetUser"}
+	ctx := context.WithValue(context.Background(), operationKey{}, operation)
+	ctx = withCallState(withRequestID(ctx, "first-caller"))
+	ctx = callopts.WithCallOptions(ctx, callopts.MaxRetries(2))
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	detached := detachedCallContext(ctx)
+	if detached.Err() != nil {
+		t.Error("detached context is cancelled with the caller's")
+	}
+	if got, _ := OperationFromContext(detached); got.String() != operation.String() {
+		t.Errorf("operation = %v, want %v", got, operation)
+	}
+	if got := callopts.FromContext(detached).MaxRetries; got != 2 {
+		t.Errorf("MaxRetries = %d, want 2", got)
+	}
+	if id := RequestIDFromContext(detached); id != "" {
+		t.Errorf("request ID %q carried over", id)
+	}
+	if detached.Value(callStateKey{}) == ctx.Value(callStateKey{}) {
+		t.Error("call state shared with the first caller")
+	}
+}
@@ -0,0 +1,204 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SlogLoggerOptions configures the structured logging interceptor. Header, body and path
+// filtering work like in LoggerOptions.
+type SlogLoggerOptions struct {
+	// Handler receives the records. Defaults to slog.Default().Handler().
+	Handler slog.Handler
+
+	LogHeaders bool // Log HTTP headers
+	LogBody    bool // Log request/response bodies
+
+	// MaxBodyLogSize is the maximum size of request/response body to log (in bytes). default is 1024 bytes
+	MaxBodyLogSize int
+	// SkipHeaders is a list of headers to exclude from logs (e.g., for security reasons)
+	SkipHeaders []string
+	// SkipPaths is a list of URL paths to exclude from logging
+	SkipPaths []string
+	// Redaction controls how secrets are hidden from logged headers and bodies
+	Redaction RedactionOptions
+
+	// SampleRate is the fraction of calls logged at debug level, between 0 and 1.
+	// Warnings and errors are always logged. Zero means 1 (log everything).
+	SampleRate float64
+}
+
+// SlogLogger is an interceptor that emits one structured record per call to a slog.Handler.
+//
+// Records carry request_id, service, operation, method, path_template, status, latency,
+// attempts, request_size and response_size. The level follows the outcome: debug for
+// successes, warn for 4xx and error for 5xx and transport errors.
+type SlogLogger struct {
+	opts     SlogLoggerOptions
+	logger   *slog.Logger
+	redactor *Redactor
+}
+
+func NewSlogLogger(opts SlogLoggerOptions) *SlogLogger {
+	if opts.Handler == nil {
+		opts.Handler = slog.Default().Handler()
+	}
+	if opts.MaxBodyLogSize == 0 {
+		opts.MaxBodyLogSize = 1024
+	}
+	if opts.SampleRate <= 0 || opts.SampleRate > 1 {
+		opts.SampleRate = 1
+	}
+	skipHeaders := make([]string, len(opts.SkipHeaders))
+	for i, header := range opts.SkipHeaders {
+		skipHeaders[i] = strings.ToLower(header)
+	}
+	opts.SkipHeaders = skipHeaders
+
+	return &SlogLogger{opts: opts, logger: slog.New(opts.Handler), redactor: NewRedactor(opts.Redaction)}
+}
+
+func (l *SlogLogger) Name() string {
+	return SlogLoggerName
+}
+
+type slogCallKey struct {
+	logger *SlogLogger
+}
+
+// slogCall is what BeforeRequest keeps for AfterResponse.
+type slogCall struct {
+	start       time.Time
+	requestBody []byte
+}
+
+// BeforeRequest notes the start time and, with LogBody, reads the request body.
+func (l *SlogLogger) BeforeRequest(data InterceptorData) (InterceptorData, error) {
+	if skipLogging(data, l.opts.SkipPaths) {
+		return data, nil
+	}
+
+	call := &slogCall{start: time.Now()}
+	if l.opts.LogBody && data.Request.Body != nil && data.Request.Body != http.NoBody {
+		body, err := io.ReadAll(data.Request.Body)
+		data.Request.Body.Close()
+		if err != nil {
+			return data, err
+		}
+		data.Request.Body = io.NopCloser(bytes.NewReader(body))
+		call.requestBody = body
+	}
+
+	data.Ctx = context.WithValue(data.Ctx, slogCallKey{l}, call)
+	data.Request = data.Request.WithContext(data.Ctx)
+	return data, nil
+}
+
+// AfterResponse emits the record of the call.
+func (l *SlogLogger) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	call, ok := data.Ctx.Value(slogCallKey{l}).(*slogCall)
+	if !ok {
+		return data, nil
+	}
+
+	level := slogLevel(data.Response, data.Error)
+	if level < slog.LevelWarn && l.opts.SampleRate < 1 && rand.Float64() >= l.opts.SampleRate {
+		return data, nil
+	}
+	if !l.logger.Enabled(data.Ctx, level) {
+		return data, nil
+	}
+
+	attrs := []slog.Attr{
+		slog.String("request_id", data.ID),
+		slog.String("method", data.Request.Method),
+	}
+	if operation, ok := OperationFromContext(data.Ctx); ok {
+		attrs = append(attrs,
+			slog.String("service", operation.Service),
+			slog.String("operation", operation.Name),
+			slog.String("path_template", operation.PathTemplate))
+	} else {
+		attrs = append(attrs, slog.String("path", data.Request.URL.Path))
+	}
+	attrs = append(attrs,
+		slog.Duration("latency", time.Since(call.start)),
+		slog.Int("attempts", AttemptsFromContext(data.Ctx)))
+	if data.Request.ContentLength >= 0 {
+		attrs = append(attrs, slog.Int64("request_size", data.Request.ContentLength))
+	}
+	if l.opts.LogHeaders {
+		attrs = append(attrs, l.headerAttr("request_headers", data.Request.Header))
+	}
+	if call.requestBody != nil {
+		attrs = append(attrs, slog.String("request_body", l.bodyValue(data.Ctx, call.requestBody, data.Request.Header)))
+	}
+
+	if data.Response != nil {
+		attrs = append(attrs, slog.Int("status", data.Response.StatusCode))
+		if l.opts.LogHeaders {
+			attrs = append(attrs, l.headerAttr("response_headers", data.Response.Header))
+		}
+		size := data.Response.ContentLength
+		if l.opts.LogBody && data.Response.Body != nil && data.Response.Body != http.NoBody {
+			body, err := io.ReadAll(data.Response.Body)
+			data.Response.Body.Close()
+			data.Response.Body = io.NopCloser(bytes.NewReader(body))
+			if err != nil {
+				attrs = append(attrs, slog.String("response_body_error", err.Error()))
+			} else {
+				size = int64(len(body))
+				attrs = append(attrs, slog.String("response_body", l.bodyValue(data.Ctx, body, data.Response.Header)))
+			}
+		}
+		if size >= 0 {
+			attrs = append(attrs, slog.Int64("response_size", size))
+		}
+	}
+
+	message := "request completed"
+	if data.Error != nil {
+		message = "request failed"
+		attrs = append(attrs, slog.String("error", data.Error.Error()))
+	}
+	l.logger.LogAttrs(data.Ctx, level, message, attrs...)
+	return data, nil
+}
+
+// slogLevel maps the outcome of a call to a level: debug for successes, warn for 4xx,
+// error for 5xx and failed calls.
+func slogLevel(response *http.Response, err error) slog.Level {
+	switch {
+	case response == nil && err != nil:
+		return slog.LevelError
+	case response == nil:
+		return slog.LevelDebug
+	case response.StatusCode >= 500:
+		return slog.LevelError
+	case response.StatusCode >= 400:
+		return slog.LevelWarn
+	}
+	return slog.LevelDebug
+}
+
+func (l *SlogLogger) headerAttr(key string, headers http.Header) slog.Attr {
+	var attrs []any
+	for name, values := range headers {
+		if headerSkipped(name, l.opts.SkipHeaders) {
+			continue
+		}
+		attrs = append(attrs, slog.String(name, l.redactor.Header(name, strings.Join(values, ", "))))
+	}
+	return slog.Group(key, attrs...)
+}
+
+// bodyValue redacts body, then truncates it.
+func (l *SlogLogger) bodyValue(ctx context.Context, body []byte, headers http.Header) string {
+	return truncateLog(l.redactor.Body(ctx, body, headers.Get("Content-Type")), l.opts.MaxBodyLogSize)
+}
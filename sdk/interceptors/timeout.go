@@ -0,0 +1,114 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Timeouts are the limits of a call. Zero means no limit.
+type Timeouts struct {
+	// Total bounds the whole call, including retries and the waits between them.
+	Total time.Duration
+	// PerAttempt bounds every single attempt of the call. An attempt that times out can be
+	// retried while the total deadline allows it.
+	PerAttempt time.Duration
+}
+
+// TimeoutPolicy sets the deadlines of calls. callopts.Timeout overrides the total of a call.
+type TimeoutPolicy struct {
+	// Default applies to operations without an entry in Operations.
+	Default Timeouts
+	// Operations overrides Default per operation, keyed by Operation.String()
+	// (e.g. "iam_v1.ListDetailedWorkspaceUsers"). Zero fields fall back to Default.
+	Operations map[string]Timeouts
+}
+
+// timeouts returns the limits of the calls of operation.
+func (p TimeoutPolicy) timeouts(operation string) Timeouts {
+	timeouts := p.Default
+	if override, ok := p.Operations[operation]; ok {
+		if override.Total > 0 {
+			timeouts.Total = override.Total
+		}
+		if override.PerAttempt > 0 {
+			timeouts.PerAttempt = override.PerAttempt
+		}
+	}
+	return timeouts
+}
+
+// ApplyTimeoutPolicy makes the transport enforce policy.
+func ApplyTimeoutPolicy(policy TimeoutPolicy) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		it.SetTimeoutPolicy(policy)
+		return nil
+	}
+}
+
+// SetTimeoutPolicy replaces the deadlines the transport puts on calls.
+func (it *InterceptorTransport) SetTimeoutPolicy(policy TimeoutPolicy) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.timeouts = policy
+}
+
+func (it *InterceptorTransport) timeoutsOf(req *http.Request) Timeouts {
+	it.mu.RLock()
+	defer it.mu.RUnlock()
+	return it.timeouts.timeouts(callName(req))
+}
+
+// Limits reported by TimeoutError.
+const (
+	TimeoutLimitTotal   = "total"
+	TimeoutLimitAttempt = "per-attempt"
+)
+
+// TimeoutError is returned when a call runs past a deadline set by a TimeoutPolicy or
+// callopts.Timeout. errors.Is(err, context.DeadlineExceeded) holds for it.
+type TimeoutError struct {
+	// Operation is the operation of the call (e.g. "iam_v1.GetUser"), or its method and
+	// path for unknown operations.
+	Operation string
+	// Limit is TimeoutLimitTotal or TimeoutLimitAttempt.
+	Limit   string
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s: %s timeout of %s exceeded", e.Operation, e.Limit, e.Timeout)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// withDeadline bounds req by timeout, recording the limit as the cause of the deadline.
+func withDeadline(req *http.Request, timeout time.Duration, limit string) (*http.Request, context.CancelFunc) {
+	ctx, cancel := context.WithTimeoutCause(req.Context(), timeout,
+		&TimeoutError{Operation: callName(req), Limit: limit, Timeout: timeout})
+	return req.WithContext(ctx), cancel
+}
+
+// deadlineError replaces err with the TimeoutError that caused it, if any.
+func deadlineError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	var timeoutErr *TimeoutError
+	if cause := context.Cause(ctx); errors.As(cause, &timeoutErr) {
+		return cause
+	}
+	return err
+}
+
+// callName names the call of req in errors.
+func callName(req *http.Request) string {
+	if operation, ok := OperationFromContext(req.Context()); ok {
+		return operation.String()
+	}
+	return req.Method + " " + req.URL.Path
+}
@@ -0,0 +1,209 @@
+// Package tracing traces SDK calls with OpenTelemetry. It only depends on the OTel API:
+// spans go to whatever TracerProvider the application configures.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+// ScopeName is the instrumentation scope of the spans.
+const ScopeName = "github.com/sotoon/sotoon-sdk-go/sdk/interceptors/tracing"
+
+// Attributes set on spans besides the HTTP semantic conventions.
+const (
+	ServiceKey             = attribute.Key("sotoon.service")
+	OperationKey           = attribute.Key("sotoon.operation")
+	URLTemplateKey         = attribute.Key("url.template")
+	CircuitBreakerKey      = attribute.Key("sotoon.circuit_breaker.key")
+	CircuitBreakerStateKey = attribute.Key("sotoon.circuit_breaker.state")
+)
+
+type Options struct {
+	// TracerProvider creates the tracer. Defaults to otel.GetTracerProvider().
+	TracerProvider trace.TracerProvider
+	// Propagator injects the span context into request headers. Defaults to the W3C
+	// trace context propagator, which sets traceparent and tracestate.
+	Propagator propagation.TextMapPropagator
+	// CircuitBreakers, when set, is asked for the state of the breaker guarding each call.
+	CircuitBreakers *interceptors.CircuitBreakerRegistry
+}
+
+// Tracer is an interceptor opening a client span per call, named after its operation
+// (e.g. "iam_v1.ListGroups"), or "HTTP <method>" for unknown operations. Add it after the
+// retry interceptor, as interceptors run AfterResponse in chain order and only then does
+// the span see the final outcome of retries, and before interceptors that may reject calls,
+// whose rejections it would otherwise miss. Breakers run as middlewares, so rejections by
+// an open breaker always reach the span. Resends made by a RetryInterceptor are part of
+// the call's span rather than spans of their own.
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+	breakers   *interceptors.CircuitBreakerRegistry
+}
+
+func NewTracer(opts Options) *Tracer {
+	if opts.TracerProvider == nil {
+		opts.TracerProvider = otel.GetTracerProvider()
+	}
+	if opts.Propagator == nil {
+		opts.Propagator = propagation.TraceContext{}
+	}
+	return &Tracer{
+		tracer: opts.TracerProvider.Tracer(ScopeName,
+			trace.WithInstrumentationVersion(constants.SDKVersion),
+			trace.WithSchemaURL(semconv.SchemaURL)),
+		propagator: opts.Propagator,
+		breakers:   opts.CircuitBreakers,
+	}
+}
+
+func (t *Tracer) Name() string {
+	return interceptors.TracingName
+}
+
+type spanKey struct {
+	tracer *Tracer
+}
+
+// BeforeRequest starts the span of the call and injects its context into the request headers.
+func (t *Tracer) BeforeRequest(data interceptors.InterceptorData) (interceptors.InterceptorData, error) {
+	if _, ok := data.Ctx.Value(spanKey{t}).(trace.Span); ok {
+		// a resend of a call this tracer already traces; a nil span tells AfterResponse
+		// to leave the call's span open
+		data.Ctx = context.WithValue(data.Ctx, spanKey{t}, trace.Span(nil))
+		data.Request = data.Request.WithContext(data.Ctx)
+		return data, nil
+	}
+
+	name := "HTTP " + data.Request.Method
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(data.Request.Method),
+		semconv.URLFull(fullURL(data.Request)),
+		semconv.ServerAddress(data.Request.URL.Hostname()),
+	}
+	if port := serverPort(data.Request); port > 0 {
+		attrs = append(attrs, semconv.ServerPort(port))
+	}
+	if operation, ok := interceptors.OperationFromContext(data.Ctx); ok {
+		name = operation.String()
+		attrs = append(attrs,
+			ServiceKey.String(operation.Service),
+			OperationKey.String(operation.Name),
+			URLTemplateKey.String(operation.PathTemplate))
+	}
+
+	ctx, span := t.tracer.Start(data.Ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+	ctx = context.WithValue(ctx, spanKey{t}, span)
+	data.Ctx = ctx
+	data.Request = data.Request.WithContext(ctx)
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(data.Request.Header))
+
+	// resends by a RetryInterceptor start from InitialRequest: give them the span too
+	if data.InitialRequest != nil {
+		data.InitialRequest = data.InitialRequest.WithContext(ctx)
+		data.InitialRequest.Header = data.InitialRequest.Header.Clone()
+		t.propagator.Inject(ctx, propagation.HeaderCarrier(data.InitialRequest.Header))
+	}
+	return data, nil
+}
+
+// AfterResponse records the outcome of the call and ends its span.
+func (t *Tracer) AfterResponse(data interceptors.InterceptorData) (interceptors.InterceptorData, error) {
+	span, _ := data.Ctx.Value(spanKey{t}).(trace.Span)
+	if span == nil {
+		return data, nil
+	}
+	defer span.End()
+
+	if attempts := interceptors.AttemptsFromContext(data.Ctx); attempts > 1 {
+		span.SetAttributes(semconv.HTTPRequestResendCount(attempts - 1))
+	}
+	if t.breakers != nil {
+		key := t.breakers.Key(data.Request)
+		if state, ok := t.breakers.State(key); ok {
+			span.SetAttributes(CircuitBreakerKey.String(key), CircuitBreakerStateKey.String(state.String()))
+		}
+	}
+
+	var openErr *interceptors.BreakerOpenError
+	if errors.As(data.Error, &openErr) {
+		span.SetAttributes(CircuitBreakerKey.String(openErr.Key), CircuitBreakerStateKey.String(openErr.State.String()))
+	}
+
+	if data.Response != nil {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(data.Response.StatusCode))
+	}
+	switch {
+	case data.Error != nil:
+		span.SetAttributes(semconv.ErrorTypeKey.String(errorType(data.Response, data.Error)))
+		span.RecordError(data.Error)
+		span.SetStatus(codes.Error, data.Error.Error())
+	case data.Response != nil && data.Response.StatusCode >= 400:
+		span.SetAttributes(semconv.ErrorTypeKey.String(strconv.Itoa(data.Response.StatusCode)))
+		span.SetStatus(codes.Error, "")
+	}
+	return data, nil
+}
+
+// errorType names the error of a failed call with low cardinality: the status code of
+// error responses and *APIErrors, a name for the SDK's own errors, else the Go type of err.
+func errorType(response *http.Response, err error) string {
+	var openErr *interceptors.BreakerOpenError
+	var apiErr *interceptors.APIError
+	switch {
+	case response != nil && response.StatusCode >= 400:
+		return strconv.Itoa(response.StatusCode)
+	case errors.As(err, &apiErr):
+		return strconv.Itoa(apiErr.StatusCode)
+	case errors.As(err, &openErr):
+		return "circuit_breaker_open"
+	case errors.Is(err, constants.ErrMaxRetriesExceeded):
+		return "max_retries_exceeded"
+	case errors.Is(err, constants.ErrClientClosed):
+		return "client_closed"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	}
+	return fmt.Sprintf("%T", err)
+}
+
+// fullURL is the request URL without credentials.
+func fullURL(req *http.Request) string {
+	if req.URL.User == nil {
+		return req.URL.String()
+	}
+	u := *req.URL
+	u.User = nil
+	return u.String()
+}
+
+func serverPort(req *http.Request) int {
+	if port, err := strconv.Atoi(req.URL.Port()); err == nil {
+		return port
+	}
+	switch req.URL.Scheme {
+	case "https":
+		return 443
+	case "http":
+		return 80
+	}
+	return 0
+}
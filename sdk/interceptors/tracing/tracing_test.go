@@ -0,0 +1,249 @@
+package tracing
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+var getUser = interceptors.Operation{
+	Service:      "iam_v1",
+	Name:         "GetUser",
+	Method:       http.MethodGet,
+	PathTemplate: "/iam/v1/api/v1/user/{userUUID}/",
+	Idempotent:   true,
+}
+
+// server answers with the statuses in turn, repeating the last one, and records the
+// traceparent header of every request.
+type server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	statuses     []int
+	traceparents []string
+}
+
+func newServer(t *testing.T, statuses ...int) *server {
+	s := &server{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.traceparents = append(s.traceparents, r.Header.Get("traceparent"))
+		status := s.statuses[0]
+		if len(s.statuses) > 1 {
+			s.statuses = s.statuses[1:]
+		}
+		s.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTracer(opts Options) (*Tracer, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	opts.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	return NewTracer(opts), exporter
+}
+
+func call(t *testing.T, transport *interceptors.InterceptorTransport, url string) error {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url+"/iam/v1/api/v1/user/42/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	return err
+}
+
+func onlySpan(t *testing.T, exporter *tracetest.InMemoryExporter) tracetest.SpanStub {
+	t.Helper()
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	return spans[0]
+}
+
+func attributeOf(span tracetest.SpanStub, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range span.Attributes {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func assertAttribute(t *testing.T, span tracetest.SpanStub, want attribute.KeyValue) {
+	t.Helper()
+	got, ok := attributeOf(span, want.Key)
+	if !ok {
+		t.Errorf("attribute %s missing", want.Key)
+		return
+	}
+	if got != want.Value {
+		t.Errorf("attribute %s = %v, want %v", want.Key, got.Emit(), want.Value.Emit())
+	}
+}
+
+func TestSpanOfCall(t *testing.T) {
+	srv := newServer(t, http.StatusOK)
+	tracer, exporter := newTracer(Options{})
+	transport := interceptors.NewInterceptorTransport(http.DefaultTransport, []interceptors.Interceptor{tracer})
+	transport.RegisterOperations(getUser)
+
+	if err := call(t, transport, srv.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	span := onlySpan(t, exporter)
+	if span.Name != "iam_v1.GetUser" {
+		t.Errorf("name = %q, want iam_v1.GetUser", span.Name)
+	}
+	if span.SpanKind != trace.SpanKindClient {
+		t.Errorf("kind = %s, want client", span.SpanKind)
+	}
+	if span.Status.Code != codes.Unset {
+		t.Errorf("status = %s, want unset", span.Status.Code)
+	}
+	for _, want := range []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(http.MethodGet),
+		semconv.URLFull(srv.URL + "/iam/v1/api/v1/user/42/"),
+		semconv.HTTPResponseStatusCode(http.StatusOK),
+		ServiceKey.String("iam_v1"),
+		OperationKey.String("GetUser"),
+		URLTemplateKey.String(getUser.PathTemplate),
+	} {
+		assertAttribute(t, span, want)
+	}
+	if _, ok := attributeOf(span, semconv.HTTPRequestResendCountKey); ok {
+		t.Error("resend count set on a call sent once")
+	}
+
+	want := "00-" + span.SpanContext.TraceID().String() + "-" + span.SpanContext.SpanID().String() + "-01"
+	if srv.traceparents[0] != want {
+		t.Errorf("traceparent = %q, want %q", srv.traceparents[0], want)
+	}
+}
+
+func TestResendsShareTheSpan(t *testing.T) {
+	srv := newServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK)
+	tracer, exporter := newTracer(Options{})
+	transport := interceptors.NewInterceptorTransport(http.DefaultTransport, nil)
+	transport.RegisterOperations(getUser)
+	// the retry resends through the same transport, running the tracer again for each resend
+	transport.AddInterceptors(
+		interceptors.NewRetryInterceptor(transport,
+			interceptors.NewRetryInterceptor_ExponentialBackoff(time.Millisecond, time.Millisecond),
+			interceptors.NewRetryInterceptor_RetryDeciderStandard(3, 0)),
+		tracer,
+	)
+
+	if err := call(t, transport, srv.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	span := onlySpan(t, exporter)
+	assertAttribute(t, span, semconv.HTTPRequestResendCount(2))
+	assertAttribute(t, span, semconv.HTTPResponseStatusCode(http.StatusOK))
+	if span.Status.Code != codes.Unset {
+		t.Errorf("status = %s, want unset", span.Status.Code)
+	}
+	if len(srv.traceparents) != 3 {
+		t.Fatalf("got %d requests, want 3", len(srv.traceparents))
+	}
+	for i, traceparent := range srv.traceparents {
+		if traceparent != srv.traceparents[0] || traceparent == "" {
+			t.Errorf("attempt %d traceparent = %q, want %q", i+1, traceparent, srv.traceparents[0])
+		}
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	srv := newServer(t, http.StatusNotFound)
+	tracer, exporter := newTracer(Options{})
+	transport := interceptors.NewInterceptorTransport(http.DefaultTransport, []interceptors.Interceptor{
+		interceptors.NewTreatAsErrorInterceptor(interceptors.NewTreatAsErrorInterceptor_ErrorDetectorAll()),
+		tracer,
+	})
+	transport.RegisterOperations(getUser)
+
+	if err := call(t, transport, srv.URL); !errors.Is(err, constants.ErrNotFound) {
+		t.Fatalf("err = %v, want ErrNotFound", err)
+	}
+
+	span := onlySpan(t, exporter)
+	if span.Status.Code != codes.Error {
+		t.Errorf("status = %s, want error", span.Status.Code)
+	}
+	assertAttribute(t, span, semconv.HTTPResponseStatusCode(http.StatusNotFound))
+	assertAttribute(t, span, semconv.ErrorTypeKey.String("404"))
+	if len(span.Events) == 0 || span.Events[0].Name != "exception" {
+		t.Error("error not recorded as an exception event")
+	}
+}
+
+func TestOpenBreaker(t *testing.T) {
+	srv := newServer(t, http.StatusServiceUnavailable)
+	registry := interceptors.NewCircuitBreakerRegistry(interceptors.CircuitBreakerRegistryOptions{
+		Settings: func(key string) gobreaker.Settings {
+			return gobreaker.Settings{
+				Name:        key,
+				Timeout:     time.Minute,
+				ReadyToTrip: func(counts gobreaker.Counts) bool { return counts.ConsecutiveFailures >= 1 },
+			}
+		},
+	})
+	tracer, exporter := newTracer(Options{CircuitBreakers: registry})
+	transport := interceptors.NewInterceptorTransport(http.DefaultTransport, []interceptors.Interceptor{tracer})
+	transport.AddMiddlewares(interceptors.NewEndpointCircuitBreakerMiddleware(registry))
+	transport.RegisterOperations(getUser)
+
+	if err := call(t, transport, srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	var openErr *interceptors.BreakerOpenError
+	if err := call(t, transport, srv.URL); !errors.As(err, &openErr) {
+		t.Fatalf("err = %v, want *BreakerOpenError", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(spans))
+	}
+	tripped, rejected := spans[0], spans[1]
+
+	assertAttribute(t, tripped, semconv.HTTPResponseStatusCode(http.StatusServiceUnavailable))
+	assertAttribute(t, tripped, CircuitBreakerKey.String("iam_v1.GetUser"))
+	assertAttribute(t, tripped, CircuitBreakerStateKey.String("open"))
+
+	if rejected.Status.Code != codes.Error {
+		t.Errorf("status = %s, want error", rejected.Status.Code)
+	}
+	assertAttribute(t, rejected, semconv.ErrorTypeKey.String("circuit_breaker_open"))
+	assertAttribute(t, rejected, CircuitBreakerKey.String("iam_v1.GetUser"))
+	assertAttribute(t, rejected, CircuitBreakerStateKey.String("open"))
+	if _, ok := attributeOf(rejected, semconv.HTTPResponseStatusCodeKey); ok {
+		t.Error("status code set on a rejected call")
+	}
+	if len(srv.traceparents) != 1 {
+		t.Errorf("server got %d requests, want 1", len(srv.traceparents))
+	}
+}
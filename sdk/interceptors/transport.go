@@ -1,21 +1,45 @@
 package interceptors
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
 )
 
 type InterceptorTransport struct {
 	rt           http.RoundTripper
+	mu           sync.RWMutex
 	interceptors []Interceptor
+	middlewares  []Middleware
+	operations   []operationMatcher
+	timeouts     TimeoutPolicy
+	closed       atomic.Bool
+	// ownsTransport is set when rt was built by ConfigureHTTPTransport, so that Close
+	// releases its connections; the shared transport and the callers' ones are left alone.
+	ownsTransport bool
 }
 
 func NewDefaultInterceptorTransport(secretKey string) *InterceptorTransport {
+	return NewDefaultInterceptorTransportWithCredentials(NewStaticCredentials(secretKey))
+}
+
+// NewDefaultInterceptorTransportWithCredentials is like NewDefaultInterceptorTransport but
+// authenticates every request with the key currently returned by credentials.
+func NewDefaultInterceptorTransportWithCredentials(credentials CredentialsProvider) *InterceptorTransport {
 	return &InterceptorTransport{
-		rt: http.DefaultTransport,
+		rt: sharedHTTPTransport,
 		interceptors: []Interceptor{
-			NewAuthenticator(secretKey),
+			NewUserAgent(""),
+			NewIdempotencyKeyInterceptor(),
+			NewAuthenticatorWithCredentials(credentials),
 		},
 	}
 }
@@ -27,8 +51,20 @@ func NewInterceptorTransport(rt http.RoundTripper, interceptors []Interceptor) *
 	}
 }
 
-func (it *InterceptorTransport) AddInterceptors(interceptors ...Interceptor) {
-	it.interceptors = append(it.interceptors, interceptors...)
+// UserAgent returns the User-Agent interceptor currently in the chain, found by
+// UserAgentName, or nil when the chain has none or it was replaced by another type.
+func (it *InterceptorTransport) UserAgent() *UserAgent {
+	for _, interceptor := range it.chain() {
+		if InterceptorName(interceptor) != UserAgentName {
+			continue
+		}
+		if named, ok := interceptor.(*namedInterceptor); ok {
+			interceptor = named.Interceptor
+		}
+		userAgent, _ := interceptor.(*UserAgent)
+		return userAgent
+	}
+	return nil
 }
 
 func (it *InterceptorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -36,7 +72,45 @@ func (it *InterceptorTransport) RoundTrip(req *http.Request) (*http.Response, er
 }
 
 func (it *InterceptorTransport) RoundTripWithID(req *http.Request, id string) (*http.Response, error) {
+	if it.closed.Load() {
+		return nil, constants.ErrClientClosed
+	}
+
+	callOptions := callopts.FromContext(req.Context())
+	if len(callOptions.Headers) > 0 {
+		// a RoundTripper must not modify the caller's request
+		req = req.Clone(req.Context())
+		for name, values := range callOptions.Headers {
+			req.Header[name] = append([]string(nil), values...)
+		}
+	}
+
+	// resends of a retry already run under the deadline of their call
+	var timeout time.Duration
+	if !isRetryAttempt(req.Context()) {
+		req = it.withOperation(req)
+		timeout = it.timeoutsOf(req).Total
+		if callOptions.Timeout > 0 {
+			timeout = callOptions.Timeout
+		}
+	}
+	if timeout <= 0 {
+		return it.roundTrip(req, id)
+	}
 
+	req, cancel := withDeadline(req, timeout, TimeoutLimitTotal)
+	resp, err := it.roundTrip(req, id)
+	if err != nil || resp.Body == nil {
+		cancel()
+		return resp, deadlineError(req.Context(), err)
+	}
+	// the deadline must outlive RoundTrip until the caller has read the body
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (it *InterceptorTransport) roundTrip(req *http.Request, id string) (*http.Response, error) {
+	req = it.withOperation(req.WithContext(withCallState(withRequestID(req.Context(), id))))
 	initialReq := req.Clone(req.Context())
 
 	var InterceptorData InterceptorData = InterceptorData{
@@ -47,35 +121,131 @@ func (it *InterceptorTransport) RoundTripWithID(req *http.Request, id string) (*
 		Response:       nil,
 		Error:          nil,
 	}
-	var err error
-	for _, interceptor := range it.interceptors {
-		InterceptorData, err = interceptor.BeforeRequest(InterceptorData)
+	chain := it.chain()
+	// ran counts the interceptors whose BeforeRequest let the call through: only their
+	// AfterResponse runs, and it runs whatever happens next, so per-call state they hold is
+	// always released. An interceptor answering the call itself is not one of them.
+	ran := 0
+	for _, interceptor := range chain {
+		data, err := interceptor.BeforeRequest(InterceptorData)
 		if err != nil {
-			return nil, err
+			InterceptorData.Error = err
+			break
 		}
+		InterceptorData = data
 		if InterceptorData.Response != nil {
-			return InterceptorData.Response, nil
+			break
 		}
-	}
-	if InterceptorData.Error != nil {
-		return nil, InterceptorData.Error
+		ran++
 	}
 
-	req = InterceptorData.Request
-	resp, err := it.rt.RoundTrip(req)
-	if err != nil {
-		return nil, err
+	if InterceptorData.Response == nil && InterceptorData.Error == nil {
+		// a failed send still runs AfterResponse, so interceptors such as retry see network errors
+		InterceptorData.Response, InterceptorData.Error = it.send(InterceptorData.Request)
+	} else {
+		// answered or rejected by a BeforeRequest
+		InterceptorData.Ctx = context.WithValue(InterceptorData.Ctx, notSentKey{}, true)
+		InterceptorData.Request = InterceptorData.Request.WithContext(InterceptorData.Ctx)
 	}
-	InterceptorData.Response = resp
 
-	for _, interceptor := range it.interceptors {
-		InterceptorData, err = interceptor.AfterResponse(InterceptorData)
+	for _, interceptor := range chain[:ran] {
+		data, err := interceptor.AfterResponse(InterceptorData)
 		if err != nil {
-			return nil, err
+			InterceptorData.Error = err
+			continue
 		}
+		InterceptorData = data
 	}
 	if InterceptorData.Error != nil {
+		discardBody(InterceptorData.Response)
 		return nil, InterceptorData.Error
 	}
-	return resp, nil
+	return InterceptorData.Response, nil
+}
+
+type notSentKey struct{}
+
+// wasSent reports whether the call reached the middlewares, rather than being answered or
+// rejected by a BeforeRequest.
+func wasSent(ctx context.Context) bool {
+	return ctx.Value(notSentKey{}) == nil
+}
+
+// Close stops the background work of every interceptor implementing io.Closer and
+// releases the idle connections of the underlying transport when it was built by
+// ConfigureHTTPTransport; the shared transport and those passed to SetTransport keep
+// serving other clients. Requests sent after Close fail with constants.ErrClientClosed.
+// Interceptors shared with other transports are closed too, so they must tolerate
+// being closed more than once.
+func (it *InterceptorTransport) Close() error {
+	if it.closed.Swap(true) {
+		return nil
+	}
+
+	var errs []error
+	for _, interceptor := range it.chain() {
+		if closer, ok := interceptor.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if it.ownsTransport {
+		it.CloseIdleConnections()
+	}
+	return errors.Join(errs...)
+}
+
+// CloseIdleConnections closes the idle connections of the underlying transport, which
+// may be shared with other clients.
+func (it *InterceptorTransport) CloseIdleConnections() {
+	if closer, ok := it.rt.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+type callStateKey struct{}
+
+// callState is the mutable state of one logical call, shared by every transport the call
+// goes through, e.g. when a retry resends it through another InterceptorTransport.
+type callState struct {
+	attempts atomic.Int32
+}
+
+func withCallState(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(callStateKey{}).(*callState); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, callStateKey{}, &callState{})
+}
+
+// AttemptsFromContext returns how many times the call has been sent so far, counting
+// every retry; zero before it is first sent.
+func AttemptsFromContext(ctx context.Context) int {
+	if state, ok := ctx.Value(callStateKey{}).(*callState); ok {
+		return int(state.attempts.Load())
+	}
+	return 0
+}
+
+// cancelOnCloseBody releases a context once the response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// discardBody drains and closes the body of a response that is being replaced,
+// so that its connection can be reused.
+func discardBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
 }
@@ -0,0 +1,60 @@
+package interceptors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/callopts"
+)
+
+func TestCallHeadersLeaveRequestAlone(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Trace")
+	}))
+	defer srv.Close()
+
+	transport := NewInterceptorTransport(http.DefaultTransport, nil)
+	req, err := http.NewRequestWithContext(
+		callopts.WithCallOptions(context.Background(), callopts.Header("X-Trace", "abc")),
+		http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got != "abc" {
+		t.Errorf("server got X-Trace %q, want abc", got)
+	}
+	if value := req.Header.Get("X-Trace"); value != "" {
+		t.Errorf("caller's request got X-Trace %q", value)
+	}
+}
+
+func TestDefaultTransportSendsEmptySecretKey(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Values("Authorization")
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := NewDefaultInterceptorTransport("").RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if len(got) != 1 || got[0] != "Bearer" {
+		t.Errorf("Authorization = %q, want the empty bearer token", got)
+	}
+}
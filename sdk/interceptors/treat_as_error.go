@@ -1,13 +1,5 @@
 package interceptors
 
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
-	"log"
-)
-
 type ErrorDetector interface {
 	// IsError will return error if any of the conditions are met
 	IsError(data InterceptorData) error
@@ -23,11 +15,20 @@ func NewTreatAsErrorInterceptor(errorDetector ErrorDetector) *TreatAsErrorInterc
 	}
 }
 
+func (a *TreatAsErrorInterceptor) Name() string {
+	return TreatAsErrorName
+}
+
 func (a *TreatAsErrorInterceptor) BeforeRequest(data InterceptorData) (InterceptorData, error) {
 	return data, nil
 }
 
+// AfterResponse sets the error the detector finds, unless the call already failed, e.g. with
+// the error of a retry interceptor that gave up.
 func (a *TreatAsErrorInterceptor) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	if data.Error != nil {
+		return data, nil
+	}
 	if err := a.ErrorDetector.IsError(data); err != nil {
 		data.Error = err
 	}
@@ -38,42 +39,14 @@ func (a *TreatAsErrorInterceptor) AfterResponse(data InterceptorData) (Intercept
 
 type treatAsErrorInterceptor_ErrorDetectorAll struct{}
 
-type errorTemplate struct {
-	Details string `json:"details"`
-	Reason  string `json:"reason"`
-	Message struct {
-		Detail string `json:"detail"`
-	} `json:"message"`
-	Error string `json:"error"`
-}
-
+// IsError returns an *APIError for responses with a status of 400 or more.
 func (a *treatAsErrorInterceptor_ErrorDetectorAll) IsError(data InterceptorData) error {
-	if data.Response != nil && data.Response.StatusCode >= 400 {
-		defaultError := fmt.Errorf("non-2xx response: %d", data.Response.StatusCode)
-		if data.Response.Body != nil {
-			body, err := io.ReadAll(data.Response.Body)
-			if err != nil {
-				return err
-			}
-			data.Response.Body = io.NopCloser(bytes.NewReader(body))
-			var errorTemplate errorTemplate
-			if err := json.Unmarshal(body, &errorTemplate); err != nil {
-				log.Println("failed to Unmarshall errorTemplate", err)
-			}
-
-			switch {
-			case errorTemplate.Message.Detail != "":
-				return fmt.Errorf("%s", errorTemplate.Message.Detail)
-			case errorTemplate.Reason != "":
-				return fmt.Errorf("%s", errorTemplate.Reason)
-			case errorTemplate.Error != "":
-				return fmt.Errorf("%s", errorTemplate.Error)
-			default:
-				return defaultError
-			}
-		}
-
-		return defaultError
+	apiErr, err := ReadAPIError(data.Response)
+	if err != nil {
+		return err
+	}
+	if apiErr != nil {
+		return apiErr
 	}
 	return nil
 }
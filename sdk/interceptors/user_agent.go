@@ -0,0 +1,93 @@
+package interceptors
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/constants"
+)
+
+// AppInfo identifies the application using the SDK in the User-Agent header.
+type AppInfo struct {
+	Name    string
+	Version string
+}
+
+func (a AppInfo) String() string {
+	if a.Version == "" {
+		return a.Name
+	}
+	return a.Name + "/" + a.Version
+}
+
+// UserAgent sets "User-Agent: sotoon-sdk-go/<version> <service> [<app>/<version> ...]" on every request.
+// It is installed by NewDefaultInterceptorTransport.
+type UserAgent struct {
+	mu      sync.RWMutex
+	service string
+	apps    []AppInfo
+}
+
+func NewUserAgent(service string) *UserAgent {
+	return &UserAgent{
+		service: service,
+	}
+}
+
+// SetService sets the service name reported after the SDK version.
+func (u *UserAgent) SetService(service string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.service = service
+}
+
+// AddAppInfo appends the application name and version to the User-Agent.
+func (u *UserAgent) AddAppInfo(name, version string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.apps = append(u.apps, AppInfo{Name: name, Version: version})
+}
+
+// AddAppInfo appends the application name and version to the User-Agent interceptor in
+// the chain. It fails with constants.ErrInterceptorNotFound when the chain has none, e.g.
+// after the interceptor was removed.
+func AddAppInfo(name, version string) ChainEdit {
+	return func(it *InterceptorTransport) error {
+		userAgent := it.UserAgent()
+		if userAgent == nil {
+			return fmt.Errorf("%w: %s", constants.ErrInterceptorNotFound, UserAgentName)
+		}
+		userAgent.AddAppInfo(name, version)
+		return nil
+	}
+}
+
+func (u *UserAgent) String() string {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	parts := []string{"sotoon-sdk-go/" + constants.SDKVersion}
+	if u.service != "" {
+		parts = append(parts, u.service)
+	}
+	for _, app := range u.apps {
+		if app.Name != "" {
+			parts = append(parts, app.String())
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func (u *UserAgent) Name() string {
+	return UserAgentName
+}
+
+func (u *UserAgent) BeforeRequest(data InterceptorData) (InterceptorData, error) {
+	data.Request.Header.Set("User-Agent", u.String())
+	return data, nil
+}
+
+func (u *UserAgent) AfterResponse(data InterceptorData) (InterceptorData, error) {
+	return data, nil
+}
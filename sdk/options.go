@@ -0,0 +1,219 @@
+package sotton
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+type sdkOptions struct {
+	endpointResolver EndpointResolver
+	serviceEndpoints map[string]string
+	chainEdits       []interceptors.ChainEdit
+	credentials      interceptors.CredentialsProvider
+	defaultWorkspace string
+	appInfo          []interceptors.AppInfo
+
+	transport http.RoundTripper
+	timeout   time.Duration
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+
+	sdkFuncs []func(SDK) SDK
+}
+
+// SDKOption configures NewSDK. Options are applied before the service handlers are built.
+type SDKOption func(*sdkOptions)
+
+// WithSDKFunc adapts an option of the former func(SDK) SDK form, which edits the SDK once
+// its handlers are built. NewSDK applies these after every other option.
+func WithSDKFunc(fn func(SDK) SDK) SDKOption {
+	return func(o *sdkOptions) {
+		o.sdkFuncs = append(o.sdkFuncs, fn)
+	}
+}
+
+func newSDKOptions(opts []SDKOption) *sdkOptions {
+	options := &sdkOptions{
+		endpointResolver: DefaultEndpointResolver{},
+		serviceEndpoints: map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// WithInterceptor adds the interceptors to every service handler.
+func WithInterceptor(added ...interceptors.Interceptor) SDKOption {
+	return WithChainEdits(interceptors.AppendInterceptors(added...))
+}
+
+// WithChainEdits applies the edits to the interceptor chain of every service handler, in order.
+func WithChainEdits(edits ...interceptors.ChainEdit) SDKOption {
+	return func(o *sdkOptions) {
+		o.chainEdits = append(o.chainEdits, edits...)
+	}
+}
+
+// WithPrependedInterceptor puts the interceptors in front of every chain, before the defaults.
+func WithPrependedInterceptor(added ...interceptors.Interceptor) SDKOption {
+	return WithChainEdits(interceptors.PrependInterceptors(added...))
+}
+
+// WithInterceptorBefore puts the interceptors right before the interceptor called name in every chain.
+func WithInterceptorBefore(name string, added ...interceptors.Interceptor) SDKOption {
+	return WithChainEdits(interceptors.InsertInterceptorsBefore(name, added...))
+}
+
+// WithInterceptorAfter puts the interceptors right after the interceptor called name in every chain.
+func WithInterceptorAfter(name string, added ...interceptors.Interceptor) SDKOption {
+	return WithChainEdits(interceptors.InsertInterceptorsAfter(name, added...))
+}
+
+// WithoutInterceptor removes the interceptor called name from every chain.
+func WithoutInterceptor(name string) SDKOption {
+	return WithChainEdits(interceptors.RemoveInterceptor(name))
+}
+
+// WithReplacedInterceptor swaps the interceptor called name for replacement in every chain,
+// e.g. to use a custom auth scheme instead of interceptors.AuthenticatorName.
+func WithReplacedInterceptor(name string, replacement interceptors.Interceptor) SDKOption {
+	return WithChainEdits(interceptors.ReplaceInterceptor(name, replacement))
+}
+
+// WithMiddleware wraps the sending of every request of every service in the middlewares.
+func WithMiddleware(middlewares ...interceptors.Middleware) SDKOption {
+	return WithChainEdits(interceptors.AppendMiddlewares(middlewares...))
+}
+
+// WithCredentialsProvider authenticates every service with the keys returned by provider.
+// It takes precedence over the secretKey passed to NewSDK.
+func WithCredentialsProvider(provider interceptors.CredentialsProvider) SDKOption {
+	return func(o *sdkOptions) {
+		o.credentials = provider
+	}
+}
+
+// WithAppInfo appends the application name and version to the User-Agent header of every request.
+// NewSDK fails with constants.ErrInterceptorNotFound when the User-Agent interceptor was removed.
+func WithAppInfo(name, version string) SDKOption {
+	return func(o *sdkOptions) {
+		o.appInfo = append(o.appInfo, interceptors.AppInfo{Name: name, Version: version})
+	}
+}
+
+// WithDefaultWorkspace records the workspace UUID returned by SDK.DefaultWorkspace.
+func WithDefaultWorkspace(workspaceUUID string) SDKOption {
+	return func(o *sdkOptions) {
+		o.defaultWorkspace = workspaceUUID
+	}
+}
+
+// WithServerAddress points every service at the given address.
+func WithServerAddress(serverAddress string) SDKOption {
+	return WithEndpointResolver(StaticEndpointResolver(serverAddress))
+}
+
+// WithRegion points every service at the preset address of the given region.
+func WithRegion(region Region) SDKOption {
+	return WithEndpointResolver(NewRegionEndpointResolver(region))
+}
+
+// WithEndpointResolver replaces the resolver used for services without an explicit override.
+func WithEndpointResolver(resolver EndpointResolver) SDKOption {
+	return func(o *sdkOptions) {
+		if resolver != nil {
+			o.endpointResolver = resolver
+		}
+	}
+}
+
+// WithServiceEndpoint overrides the address of a single service (e.g. "iam_v1").
+func WithServiceEndpoint(service, serverAddress string) SDKOption {
+	return func(o *sdkOptions) {
+		o.serviceEndpoints[service] = serverAddress
+	}
+}
+
+// WithHTTPTransport sets the RoundTripper shared by all service handlers.
+func WithHTTPTransport(rt http.RoundTripper) SDKOption {
+	return func(o *sdkOptions) {
+		o.transport = rt
+	}
+}
+
+// WithTimeout sets the overall timeout of each call made through any service handler.
+func WithTimeout(timeout time.Duration) SDKOption {
+	return func(o *sdkOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithTimeoutPolicy sets default and per-operation deadlines, total and per attempt, on the
+// calls of every service handler.
+func WithTimeoutPolicy(policy interceptors.TimeoutPolicy) SDKOption {
+	return WithChainEdits(interceptors.ApplyTimeoutPolicy(policy))
+}
+
+// WithTLSConfig sets the TLS configuration (CA bundle, client certificates, ...) of the shared transport.
+func WithTLSConfig(config *tls.Config) SDKOption {
+	return func(o *sdkOptions) {
+		o.tlsConfig = config
+	}
+}
+
+// WithProxy sets the proxy function of the shared transport, e.g. http.ProxyURL(u).
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) SDKOption {
+	return func(o *sdkOptions) {
+		o.proxy = proxy
+	}
+}
+
+// httpTransport builds the single RoundTripper shared by all handlers, reporting whether
+// it was created here rather than passed in. A nil result keeps the handlers' default
+// shared transport.
+func (o *sdkOptions) httpTransport() (rt http.RoundTripper, created bool, err error) {
+	if o.tlsConfig == nil && o.proxy == nil {
+		return o.transport, false, nil
+	}
+
+	rt = o.transport
+	if rt == nil {
+		rt = interceptors.NewHTTPTransport()
+	}
+	transport, ok := rt.(*http.Transport)
+	if !ok {
+		return nil, false, fmt.Errorf("TLS and proxy options require an *http.Transport, got %T", rt)
+	}
+	transport = transport.Clone()
+	if o.tlsConfig != nil {
+		transport.TLSClientConfig = o.tlsConfig
+	}
+	if o.proxy != nil {
+		transport.Proxy = o.proxy
+	}
+	return transport, true, nil
+}
+
+// credentialsProvider picks the explicit provider, then the static secretKey,
+// and finally the default chain (SOTOON_SECRET_KEY, then ~/.sotoon/credentials).
+func (o *sdkOptions) credentialsProvider(secretKey string) interceptors.CredentialsProvider {
+	switch {
+	case o.credentials != nil:
+		return o.credentials
+	case secretKey != "":
+		return interceptors.NewStaticCredentials(secretKey)
+	default:
+		return interceptors.NewDefaultCredentialsChain()
+	}
+}
+
+// DefaultWorkspace returns the workspace UUID set by WithDefaultWorkspace or by the profile.
+func (s *SDK) DefaultWorkspace() string {
+	return s.defaultWorkspace
+}
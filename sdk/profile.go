@@ -0,0 +1,71 @@
+package sotton
+
+import (
+	"time"
+
+	"github.com/sotoon/sotoon-sdk-go/sdk/config"
+	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
+)
+
+// NewSDKFromProfile builds an SDK from a named profile of the default configuration
+// file (see config.DefaultPath). Options passed here are applied after the profile's.
+func NewSDKFromProfile(name string, opts ...SDKOption) (*SDK, error) {
+	profile, err := config.LoadProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewSDKWithProfile(profile, opts...)
+}
+
+// NewSDKWithProfile builds an SDK from an already loaded profile.
+func NewSDKWithProfile(profile *config.Profile, opts ...SDKOption) (*SDK, error) {
+	return NewSDK("", append(profileOptions(profile), opts...)...)
+}
+
+func profileOptions(profile *config.Profile) []SDKOption {
+	opts := []SDKOption{
+		WithCredentialsProvider(profile.CredentialsProvider()),
+		WithTimeout(profile.Timeout),
+		WithDefaultWorkspace(profile.Workspace),
+	}
+
+	switch {
+	case profile.Endpoint != "":
+		opts = append(opts, WithServerAddress(profile.Endpoint))
+	case profile.Region != "":
+		opts = append(opts, WithRegion(Region(profile.Region)))
+	}
+	for service, endpoint := range profile.ServiceEndpoints {
+		opts = append(opts, WithServiceEndpoint(service, endpoint))
+	}
+
+	if profile.Logging != nil {
+		opts = append(opts, WithInterceptor(interceptors.NewLogger(interceptors.LoggerOptions{
+			LogBasicInfo:   profile.Logging.Basic,
+			LogHeaders:     profile.Logging.Headers,
+			LogBody:        profile.Logging.Body,
+			MaxBodyLogSize: profile.Logging.MaxBodySize,
+			SkipHeaders:    []string{"authorization"},
+		})))
+	}
+	if profile.Retry != nil && profile.Retry.MaxRetries > 0 {
+		baseDelay, maxDelay := profile.Retry.BaseDelay, profile.Retry.MaxDelay
+		if baseDelay == 0 {
+			baseDelay = 200 * time.Millisecond
+		}
+		if maxDelay == 0 {
+			maxDelay = 5 * time.Second
+		}
+		// resends go through each handler's own transport, keeping its settings and chain
+		opts = append(opts,
+			WithMiddleware(interceptors.NewRetryMiddleware(
+				interceptors.NewRetryInterceptor_ExponentialBackoff(baseDelay, maxDelay),
+				interceptors.NewRetryInterceptor_RetryDeciderStandard(profile.Retry.MaxRetries, 0),
+			)),
+			WithInterceptor(interceptors.NewTreatAsErrorInterceptor(
+				interceptors.NewTreatAsErrorInterceptor_ErrorDetectorAll(),
+			)),
+		)
+	}
+	return opts
+}
@@ -1,39 +1,67 @@
 package sotton
 
 import (
-	iam_v1 "github.com/sotoon/sotoon-sdk-go/sdk/core/iam_v1"
-	"github.com/sotoon/sotoon-sdk-go/sdk/interceptors"
-)
+	"errors"
+	"net/http"
 
-const (
-	serverAddress = "https://api.sotoon.ir"
+	iam_v1 "github.com/sotoon/sotoon-sdk-go/sdk/core/iam_v1"
 )
 
 type SDK struct {
 	Iam_v1 *iam_v1.Handler
-}
 
-type SDKOption func(SDK) SDK
+	defaultWorkspace string
+	// ownTransport is the transport NewSDK built for TLS or proxy options, released by Close.
+	ownTransport http.RoundTripper
+}
 
 func NewSDK(secretKey string, opts ...SDKOption) (*SDK, error) {
+	options := newSDKOptions(opts)
+	credentials := options.credentialsProvider(secretKey)
+	httpTransport, created, err := options.httpTransport()
+	if err != nil {
+		return nil, err
+	}
 
-	iam_v1Client, err := iam_v1.NewHandler(serverAddress, secretKey)
+	iam_v1Endpoint, err := options.resolveEndpoint("iam_v1")
+	if err != nil {
+		return nil, err
+	}
+	iam_v1Options := []iam_v1.HandlerOption{
+		iam_v1.WithHTTPTransport(httpTransport),
+		iam_v1.WithTimeout(options.timeout),
+		iam_v1.WithChainEdits(options.chainEdits...),
+	}
+	for _, app := range options.appInfo {
+		iam_v1Options = append(iam_v1Options, iam_v1.WithAppInfo(app.Name, app.Version))
+	}
+	iam_v1Client, err := iam_v1.NewHandlerWithCredentials(iam_v1Endpoint, credentials, iam_v1Options...)
 	if err != nil {
 		return nil, err
 	}
 
 	sdk := SDK{
-		Iam_v1: iam_v1Client,
+		Iam_v1:           iam_v1Client,
+		defaultWorkspace: options.defaultWorkspace,
+	}
+	if created {
+		sdk.ownTransport = httpTransport
 	}
-	for _, opt := range opts {
-		sdk = opt(sdk)
+	for _, fn := range options.sdkFuncs {
+		sdk = fn(sdk)
 	}
 	return &sdk, nil
 }
 
-func WithInterceptor(interceptors ...interceptors.Interceptor) SDKOption {
-	return func(s SDK) SDK {
-		s.Iam_v1.AddInterceptors(interceptors...)
-		return s
+// Close closes every service handler and releases the idle connections of the transport the
+// SDK built, if any. Transports shared with other SDKs or passed with WithHTTPTransport are
+// left alone. Calls made after Close fail with constants.ErrClientClosed.
+func (s *SDK) Close() error {
+	err := errors.Join(
+		s.Iam_v1.Close(),
+	)
+	if closer, ok := s.ownTransport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
 	}
+	return err
 }